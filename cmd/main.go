@@ -1,122 +1,284 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
 	"fyne.io/fyne/v2/container"
-	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/lang"
 	"fyne.io/fyne/v2/widget"
+	"golang.org/x/text/language"
 
+	"contacts/internal/config"
+	"contacts/internal/domain/avatar"
+	"contacts/internal/domain/date"
+	"contacts/internal/domain/duplicates"
+	"contacts/internal/domain/order"
+	"contacts/internal/domain/recent"
+	domainReminder "contacts/internal/domain/reminder"
 	contactValidator "contacts/internal/domain/validate/contact"
+	"contacts/internal/domain/vcard"
+	avatarContact "contacts/internal/handler/avatar"
+	birthdaysContact "contacts/internal/handler/birthdays"
 	createContact "contacts/internal/handler/create"
 	deleteContact "contacts/internal/handler/delete"
+	duplicatesContact "contacts/internal/handler/duplicates"
+	exportContact "contacts/internal/handler/export"
+	favoriteContact "contacts/internal/handler/favorite"
 	fetchContact "contacts/internal/handler/fetch"
+	interactionContact "contacts/internal/handler/interaction"
+	mergeContact "contacts/internal/handler/merge"
+	organizationContact "contacts/internal/handler/organization"
+	relationsContact "contacts/internal/handler/relations"
 	searchContact "contacts/internal/handler/search"
+	tagContact "contacts/internal/handler/tag"
 	updateContact "contacts/internal/handler/update"
+	"contacts/internal/i18n"
+	"contacts/internal/model"
+	"contacts/internal/scheduler"
 	"contacts/internal/storage"
+	"contacts/internal/storage/blob"
 	"contacts/internal/storage/database"
+	storageOrganization "contacts/internal/storage/organization"
+	"contacts/ui/appearance"
+	uiLayout "contacts/ui/layout"
 	"contacts/ui/menu"
+	uiRecent "contacts/ui/recent"
+	"contacts/ui/reminder"
+	"contacts/ui/report"
+	"contacts/ui/resources"
+	"contacts/ui/tray"
+	widgetAvatar "contacts/ui/widget/avatar"
 	widgetBirthday "contacts/ui/widget/birthday"
 	widgetContactsList "contacts/ui/widget/contacts_list"
+	widgetReconnect "contacts/ui/widget/reconnect"
 	windowAbout "contacts/ui/window/about"
 	windowCreateContact "contacts/ui/window/create_contact"
 	windowDeleteContact "contacts/ui/window/delete_contact"
+	windowExportContacts "contacts/ui/window/export_contacts"
+	windowMergeContacts "contacts/ui/window/merge_contacts"
+	windowOrganizations "contacts/ui/window/organizations"
+	windowSettings "contacts/ui/window/settings"
+	windowTagContacts "contacts/ui/window/tag_contacts"
 	windowUpdateContact "contacts/ui/window/update_contact"
+	"contacts/util/clock"
 	"contacts/util/uuid"
 )
 
+const (
+	appID = "com.github.lahainee.contacts"
+
+	// Напоминания: с какого часа утра и за сколько дней до дня рождения
+	reminderMorningHour        = 9
+	birthdayReminderDaysBefore = 3
+)
+
 var (
-	appWindowSize = fyne.NewSize(1920, 1080)
-	buttonSize    = fyne.NewSize(30, 30)
+	appWindowSize = fyne.NewSize(1200, 800)
 )
 
-func main() {
-	// Конфигурация приложения
-	contactStorage := storage.New(database.New("internal/database/database.json"))
+// Доля ширины окна под список контактов по умолчанию
+const contactsListSplitOffset = 0.35
 
-	validator := contactValidator.New()
+func main() {
+	// Пути и параметры запуска: флаги, переменные окружения и файл конфигурации
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:], os.LookupEnv)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
 
-	uuidGenerator := uuid.NewGenerator()
-
-	createContactHandler := createContact.NewHandler(contactStorage, uuidGenerator, validator)
-	updateContactHandler := updateContact.NewHandler(contactStorage, validator)
-	deleteContactHandler := deleteContact.NewHandler(contactStorage)
-	fetchContactHandler := fetchContact.NewHandler(contactStorage)
-	searchContactHandler := searchContact.NewHandler(contactStorage)
+	// Ошибки пишем в stderr с контекстом операции
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	slog.SetDefault(logger)
 
 	// Создание нового приложения
-	myApp := app.New()
+	// ID нужен для хранения настроек приложения
+	myApp := app.NewWithID(appID)
+	myApp.SetIcon(resources.AppIcon)
 	myApp.Quit()
-	myApp.Settings().SetTheme(theme.LightTheme())
-	myWindow := myApp.NewWindow("Contacts App")
-	myWindow.Resize(appWindowSize)
-	appBox := container.NewWithoutLayout()
 
-	contacts, err := contactStorage.Fetch()
+	// Тема, акцентный цвет и масштаб шрифта из настроек пользователя
+	appearanceStore := appearance.NewStore(myApp.Preferences())
+	appearanceSettings := appearanceStore.Load()
+	// Тема из конфигурации действует на время запуска и не сохраняется
+	if cfg.Theme != "" {
+		appearanceSettings.Variant = appearance.Variant(cfg.Theme)
+	}
+	appearance.Apply(myApp.Settings(), appearanceSettings)
+
+	catalog, err := i18n.New(
+		cfg.Locale,
+		myApp.Preferences().String(windowSettings.LocalePreferenceKey),
+		lang.SystemLocale().LanguageString(),
+	)
 	if err != nil {
-		panic(err)
+		logger.Error("load translations", "error", err)
+		os.Exit(1)
 	}
 
-	// <! Иконки для кнопок
-	createContactIcon, err := fyne.LoadResourceFromPath("./ui/icons/plus.png")
+	reporter := report.NewReporter(logger, catalog)
+
+	// Даты показываем в формате выбранного языка
+	dateFormatter := date.NewFormatter(catalog.Locale())
+
+	// Раньше база лежала в каталоге проекта, при первом запуске после обновления переносим ее на новое место
+	migratedLegacy, err := config.MigrateLegacyDatabase(cfg, os.LookupEnv, config.LegacyDatabasePath)
 	if err != nil {
-		panic(err)
+		logger.Warn("migrate legacy database", "from", config.LegacyDatabasePath, "to", cfg.DatabasePath, "error", err)
+	} else if migratedLegacy {
+		logger.Warn("database moved", "from", config.LegacyDatabasePath, "to", cfg.DatabasePath)
 	}
 
-	editContactIcon, err := fyne.LoadResourceFromPath("./ui/icons/edit.png")
+	// Конфигурация приложения
+	// Совпадение телефона или email с другим контактом не запрещаем, но предупреждаем
+	contactDatabase, err := openDatabase(cfg)
 	if err != nil {
-		panic(err)
+		logger.Error("open database", "backend", cfg.Backend, "path", cfg.DatabasePath, "error", err)
+		os.Exit(1)
 	}
 
-	deleteContactIcon, err := fyne.LoadResourceFromPath("./ui/icons/minus.png")
+	// Недоступная база не роняет приложение: список покажет ошибку и предложит повторить
+	err = contactDatabase.Init()
 	if err != nil {
-		panic(err)
+		logger.Error("init database", "path", cfg.DatabasePath, "error", err)
 	}
-	// Иконки для кнопок !>
 
-	contactsListWidgetBuilder := widgetContactsList.NewBuilder(fetchContactHandler, searchContactHandler, appBox)
-	contactsListWidgetBuilder.Build()
+	contactStorage := storage.New(
+		contactDatabase,
+		storage.UniqueIndex{
+			Field: model.FieldPhone,
+			Mode:  model.UniqueModeWarn,
+		},
+		storage.UniqueIndex{
+			Field: model.FieldEmail,
+			Mode:  model.UniqueModeWarn,
+		},
+	)
 
-	contactListPos := contactsListWidgetBuilder.ContactListBoxPos()
-	contactListSize := contactsListWidgetBuilder.ContactListBoxSize()
+	appClock := clock.New()
 
-	const horizontalSpacingBetweenButtons = 5
+	// Контакты из версий без метаданных получают дату создания и источник.
+	// Ошибка не мешает запуску, миграция повторится при следующем старте.
+	migrated, err := contactStorage.Migrate(appClock.Now())
+	if err != nil {
+		logger.Error("migrate contacts", "path", cfg.DatabasePath, "error", err)
+	} else if migrated > 0 {
+		logger.Info("migrate contacts", "path", cfg.DatabasePath, "migrated", migrated)
+	}
+
+	// Организации лежат рядом с базой контактов в отдельном файле
+	organizationsPath := filepath.Join(filepath.Dir(cfg.DatabasePath), "organizations.json")
+	organizationDatabase := database.New[storageOrganization.Organization](organizationsPath)
+	err = organizationDatabase.Init()
+	if err != nil {
+		logger.Error("init organizations", "path", organizationsPath, "error", err)
+	}
+	organizationStorage := storageOrganization.New(organizationDatabase)
+
+	// Фото контактов лежат рядом с базой, имя файла – хэш содержимого
+	avatarStorage := blob.New(filepath.Join(filepath.Dir(cfg.DatabasePath), "avatars"))
+
+	validator := contactValidator.New(dateFormatter)
+
+	uuidGenerator := uuid.NewGenerator()
+
+	// Имена и названия упорядочиваются по правилам русского языка во всем приложении
+	sorter := order.NewSorter(language.Russian)
+
+	organizationContactHandler := organizationContact.NewHandler(organizationStorage, contactStorage, uuidGenerator, sorter, appClock)
+	createContactHandler := createContact.NewHandler(contactStorage, organizationContactHandler, uuidGenerator, validator, dateFormatter, appClock)
+	updateContactHandler := updateContact.NewHandler(contactStorage, organizationContactHandler, validator, dateFormatter, appClock)
+	deleteContactHandler := deleteContact.NewHandler(contactStorage)
+	fetchContactHandler := fetchContact.NewHandler(contactStorage)
+	searchContactHandler := searchContact.NewHandler(contactStorage)
+	avatarContactHandler := avatarContact.NewHandler(avatarStorage, avatar.NewThumbnailer(avatar.DefaultSize))
+	duplicatesContactHandler := duplicatesContact.NewHandler(contactStorage, duplicates.NewFinder(duplicates.DefaultThreshold))
+	mergeContactHandler := mergeContact.NewHandler(contactStorage, appClock)
+	tagContactHandler := tagContact.NewHandler(contactStorage, appClock)
+	favoriteContactHandler := favoriteContact.NewHandler(contactStorage, appClock)
+	exportContactHandler := exportContact.NewHandler(contactStorage, organizationStorage, avatarStorage, vcard.NewEncoder())
+	birthdaysContactHandler := birthdaysContact.NewHandler(contactStorage, sorter, appClock)
+	relationsContactHandler := relationsContact.NewHandler(contactStorage, sorter, appClock)
+	interactionContactHandler := interactionContact.NewHandler(contactStorage, uuidGenerator, dateFormatter, sorter, appClock)
+
+	myWindow := myApp.NewWindow(catalog.T("app.title"))
+	reporter.SetWindow(myWindow)
+
+	avatarWidgetBuilder := widgetAvatar.NewBuilder(avatarContactHandler)
+
+	// Недавно открытые контакты хранятся в настройках приложения
+	recentStore := uiRecent.NewStore(myApp.Preferences(), recent.NewTracker(recent.DefaultLimit))
+
+	contactsListWidgetBuilder := widgetContactsList.NewBuilder(
+		fetchContactHandler,
+		searchContactHandler,
+		updateContactHandler,
+		favoriteContactHandler,
+		birthdaysContactHandler,
+		relationsContactHandler,
+		organizationContactHandler,
+		interactionContactHandler,
+		validator,
+		avatarWidgetBuilder,
+		recentStore,
+		sorter,
+		reporter,
+		catalog,
+		dateFormatter,
+	)
+	contactsListWidget := contactsListWidgetBuilder.Build()
 
 	// Компонент отвечающий за создание контакта
 	createContactWindowBuilder := windowCreateContact.NewBuilder(
 		myApp,
 		contactsListWidgetBuilder,
 		createContactHandler,
+		organizationContactHandler,
+		validator,
+		avatarWidgetBuilder,
+		reporter,
+		catalog,
+		dateFormatter,
 	)
-	createContactButton := widget.NewButtonWithIcon("", createContactIcon, func() {
+	createContactButton := widget.NewButtonWithIcon("", resources.CreateIcon, func() {
 		createContactWindow := createContactWindowBuilder.Build()
 		createContactWindow.Show()
 	})
-	createContactButton.Resize(buttonSize)
-	createContactButton.Move(fyne.NewPos(contactListPos.X, contactListPos.Y+contactListSize.Height+20))
 
 	// Компонент отвечающий за изменение контакта
 	updateContactWindowBuilder := windowUpdateContact.NewBuilder(
 		myApp,
 		contactsListWidgetBuilder,
 		updateContactHandler,
+		validator,
 		fetchContactHandler,
+		organizationContactHandler,
+		avatarWidgetBuilder,
+		reporter,
+		catalog,
+		dateFormatter,
 	)
-	updateContactButton := widget.NewButtonWithIcon("", editContactIcon, func() {
+	updateContactButton := widget.NewButtonWithIcon("", resources.EditIcon, func() {
 		selectedContactUUID := contactsListWidgetBuilder.SelectedContactUUID()
 		if selectedContactUUID == nil {
 			return
 		}
 
 		updateContactWindow := updateContactWindowBuilder.Build(*selectedContactUUID)
+		if updateContactWindow == nil {
+			return
+		}
 		updateContactWindow.Show()
 	})
-	updateContactButton.Resize(buttonSize)
-	updateContactButton.Move(
-		fyne.NewPos(
-			createContactButton.Position().X+createContactButton.Size().Width+horizontalSpacingBetweenButtons,
-			createContactButton.Position().Y,
-		))
 
 	// Компонент отвечающий за удаление контакта
 	deleteContactWindowBuilder := windowDeleteContact.NewBuilder(
@@ -124,37 +286,99 @@ func main() {
 		deleteContactHandler,
 		fetchContactHandler,
 		contactsListWidgetBuilder,
+		reporter,
+		catalog,
 	)
-	deleteContactButton := widget.NewButtonWithIcon("", deleteContactIcon, func() {
-		selectedContactUUID := contactsListWidgetBuilder.SelectedContactUUID()
-		if selectedContactUUID == nil {
+	deleteContactButton := widget.NewButtonWithIcon("", resources.DeleteIcon, func() {
+		selectedContactUUIDs := contactsListWidgetBuilder.SelectedContactUUIDs()
+
+		// Для нескольких контактов – одно подтверждение со списком имен
+		var deleteContactWindow fyne.Window
+		switch len(selectedContactUUIDs) {
+		case 0:
 			return
+		case 1:
+			deleteContactWindow = deleteContactWindowBuilder.Build(selectedContactUUIDs[0])
+		default:
+			deleteContactWindow = deleteContactWindowBuilder.BuildMany(selectedContactUUIDs)
 		}
 
-		deleteContactWindow := deleteContactWindowBuilder.Build(*selectedContactUUID)
+		if deleteContactWindow == nil {
+			return
+		}
 		deleteContactWindow.Show()
 	})
-	deleteContactButton.Resize(buttonSize)
-	deleteContactButton.Move(
-		fyne.NewPos(
-			updateContactButton.Position().X+updateContactButton.Size().Width+horizontalSpacingBetweenButtons,
-			updateContactButton.Position().Y,
-		))
-
-	aboutWindowBuilder := windowAbout.NewBuilder(myApp)
-
-	// Виджет с напоминанием о днях рождения
-	birthdayWidgetBuilder := widgetBirthday.NewBuilder(appWindowSize)
-	birthdayWidget := birthdayWidgetBuilder.Build(contacts)
-	if birthdayWidget != nil {
-		birthdayWidget.Move(fyne.NewPos(contactListPos.X+contactListSize.Width+20, contactListPos.Y+contactListSize.Height-50))
-		appBox.Add(birthdayWidget)
+
+	// Массовые действия с выбранными контактами
+	tagContactsWindowBuilder := windowTagContacts.NewBuilder(
+		myApp,
+		contactsListWidgetBuilder,
+		tagContactHandler,
+		reporter,
+		catalog,
+	)
+	exportContactsBuilder := windowExportContacts.NewBuilder(myWindow, exportContactHandler, reporter)
+
+	mergeContactsWindowBuilder := windowMergeContacts.NewBuilder(
+		myApp,
+		contactsListWidgetBuilder,
+		duplicatesContactHandler,
+		mergeContactHandler,
+		organizationContactHandler,
+		catalog,
+		dateFormatter,
+	)
+
+	organizationsWindowBuilder := windowOrganizations.NewBuilder(
+		myApp,
+		contactsListWidgetBuilder,
+		organizationContactHandler,
+		reporter,
+		catalog,
+	)
+
+	aboutWindowBuilder := windowAbout.NewBuilder(myApp, catalog)
+
+	// Тема и язык из конфигурации важнее выбранных в настройках
+	settingsOverrides := windowSettings.Overrides{Variant: appearance.Variant(cfg.Theme)}
+	if cfg.Locale != "" {
+		settingsOverrides.Locale = catalog.Locale()
 	}
+	settingsWindowBuilder := windowSettings.NewBuilder(myApp, appearanceStore, catalog, catalog.Locales(), settingsOverrides)
+
+	// Виджет с ближайшими днями рождения
+	birthdayWidgetBuilder := widgetBirthday.NewBuilder(birthdaysContactHandler, reporter, catalog, dateFormatter, cfg.BirthdayHorizon)
+	birthdayWidget := birthdayWidgetBuilder.Build()
+
+	// Виджет с контактами, с которыми пора связаться
+	reconnectWidgetBuilder := widgetReconnect.NewBuilder(interactionContactHandler, contactsListWidgetBuilder, reporter, catalog, dateFormatter)
+	reconnectWidget := reconnectWidgetBuilder.Build()
+	contactsListWidgetBuilder.OnInteractionsChanged(reconnectWidgetBuilder.Refresh)
+
+	// Слева – поиск, список и кнопки, справа – карточка контакта, кому пора позвонить и дни рождения
+	leftPane := container.NewBorder(
+		nil,
+		container.NewHBox(createContactButton, updateContactButton, deleteContactButton),
+		nil,
+		nil,
+		contactsListWidget,
+	)
+	rightPane := container.NewBorder(
+		nil,
+		container.NewVBox(reconnectWidget, birthdayWidget),
+		nil,
+		nil,
+		contactsListWidgetBuilder.InfoPanel(),
+	)
+	split := container.NewHSplit(leftPane, rightPane)
+	myWindow.SetContent(split)
 
-	appBox.Add(createContactButton)
-	appBox.Add(updateContactButton)
-	appBox.Add(deleteContactButton)
-	myWindow.SetContent(appBox)
+	// Размер окна и положение разделителя переживают перезапуск
+	layoutStore := uiLayout.NewStore(myApp.Preferences(), appWindowSize, contactsListSplitOffset)
+	layoutStore.Restore(myWindow, split)
+	myApp.Lifecycle().SetOnStopped(func() {
+		layoutStore.Save(myWindow, split)
+	})
 
 	mainMenuBuilder := menu.NewBuilder(
 		myApp,
@@ -162,9 +386,57 @@ func main() {
 		createContactWindowBuilder,
 		updateContactWindowBuilder,
 		deleteContactWindowBuilder,
+		tagContactsWindowBuilder,
+		exportContactsBuilder,
+		mergeContactsWindowBuilder,
+		organizationsWindowBuilder,
 		aboutWindowBuilder,
+		settingsWindowBuilder,
+		catalog,
 	)
 	myWindow.SetMainMenu(mainMenuBuilder.Build())
+	mainMenuBuilder.BindShortcuts(myWindow.Canvas())
+
+	// Напоминания о днях рождения и о тех, с кем пора связаться, работают в фоне, пока приложение запущено
+	reminderPlanner := domainReminder.NewPlanner(reminderMorningHour, birthdayReminderDaysBefore)
+	birthdayReminderJob := reminder.NewBirthdayJob(
+		myApp,
+		myApp.Preferences(),
+		birthdaysContactHandler,
+		reminderPlanner,
+		catalog,
+		dateFormatter,
+	)
+	reconnectReminderJob := reminder.NewReconnectJob(
+		myApp,
+		myApp.Preferences(),
+		interactionContactHandler,
+		reminderPlanner,
+		catalog,
+		dateFormatter,
+	)
+	reconnectReminderJob.OnDue(reconnectWidgetBuilder.Set)
+
+	if desktopApp, ok := myApp.(desktop.App); ok {
+		trayBuilder := tray.NewBuilder(myApp, desktopApp, myWindow, birthdayReminderJob, appClock, catalog)
+		trayBuilder.Build()
+		birthdayReminderJob.OnToday(trayBuilder.Refresh)
+
+		// При закрытии окна приложение остается в трее
+		myWindow.SetCloseIntercept(myWindow.Hide)
+	}
+
+	go scheduler.New(appClock, time.Minute, birthdayReminderJob, reconnectReminderJob).Run(context.Background())
 
 	myWindow.ShowAndRun()
 }
+
+// openDatabase – база контактов выбранного в конфигурации типа
+func openDatabase(cfg config.Config) (*database.Database[storage.Contact], error) {
+	switch cfg.Backend {
+	case config.BackendJSON:
+		return database.New[storage.Contact](cfg.DatabasePath), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", config.ErrUnsupportedBackend, cfg.Backend)
+	}
+}
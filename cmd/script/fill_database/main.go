@@ -2,19 +2,31 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"time"
 
+	"golang.org/x/text/language"
+
+	"contacts/internal/config"
+	"contacts/internal/domain/date"
+	"contacts/internal/domain/order"
 	contactValidator "contacts/internal/domain/validate/contact"
 	createContact "contacts/internal/handler/create"
+	organizationContact "contacts/internal/handler/organization"
 	"contacts/internal/model"
 	"contacts/internal/storage"
 	"contacts/internal/storage/database"
+	storageOrganization "contacts/internal/storage/organization"
+	"contacts/util/clock"
 	"contacts/util/uuid"
 )
 
-const amount = 10
+// Количество контактов по умолчанию
+const defaultAmount = 10
 
 var names = []string{
 	"Александр", "Дмитрий", "Максим", "Иван", "Сергей",
@@ -45,20 +57,60 @@ func generateRandomDate() string {
 	return randomDate.Format("02.01.2006")
 }
 
+// generateRandomPhone – случайный номер, чтобы контакты не выглядели дубликатами друг друга
+func generateRandomPhone() string {
+	return fmt.Sprintf("+7 (9%02d) %03d-%02d-%02d", rand.Intn(100), rand.Intn(1000), rand.Intn(100), rand.Intn(100))
+}
+
+func generateRandomEmail() string {
+	return fmt.Sprintf("user%06d@example.com", rand.Intn(1000000))
+}
+
 func main() {
-	contactStorage := storage.New(database.New("internal/database/database.json"))
-	validator := contactValidator.New()
+	amount := flag.Int("amount", defaultAmount, "number of contacts to generate")
+
+	// База та же, что у приложения: флаги, переменные окружения и файл конфигурации
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:], os.LookupEnv)
+	if err != nil {
+		panic(err)
+	}
+
+	if cfg.Backend != config.BackendJSON {
+		panic(fmt.Errorf("%w: %q", config.ErrUnsupportedBackend, cfg.Backend))
+	}
+
+	contactDatabase := database.New[storage.Contact](cfg.DatabasePath)
+	err = contactDatabase.Init()
+	if err != nil {
+		panic(err)
+	}
+
+	contactStorage := storage.New(contactDatabase)
+
+	// Организации лежат рядом с базой контактов, как у приложения
+	organizationDatabase := database.New[storageOrganization.Organization](filepath.Join(filepath.Dir(cfg.DatabasePath), "organizations.json"))
+	err = organizationDatabase.Init()
+	if err != nil {
+		panic(err)
+	}
+
+	organizationStorage := storageOrganization.New(organizationDatabase)
+	// Даты генерируются в формате 02.01.2006
+	dateFormatter := date.NewFormatter("ru")
+	validator := contactValidator.New(dateFormatter)
 	uuidGenerator := uuid.NewGenerator()
-	createContactHandler := createContact.NewHandler(contactStorage, uuidGenerator, validator)
+	appClock := clock.New()
+	organizationHandler := organizationContact.NewHandler(organizationStorage, contactStorage, uuidGenerator, order.NewSorter(language.Russian), appClock)
+	createContactHandler := createContact.NewHandler(contactStorage, organizationHandler, uuidGenerator, validator, dateFormatter, appClock)
 
 	contacts := make([]model.ContactForCreate, 0)
-	for i := 0; i < amount; i++ {
+	for i := 0; i < *amount; i++ {
 		contacts = append(contacts, model.ContactForCreate{
 			Surname:  getRandom(surnames),
 			Name:     getRandom(names),
 			Birthday: generateRandomDate(),
-			Phone:    "+7 (915) 159-67-81",
-			Email:    "vaershov@avito.ru",
+			Phone:    generateRandomPhone(),
+			Email:    generateRandomEmail(),
 		})
 	}
 
@@ -69,5 +121,5 @@ func main() {
 		}
 	}
 
-	fmt.Println("Finished")
+	fmt.Printf("Finished: %d contacts added to %s\n", *amount, cfg.DatabasePath)
 }
@@ -0,0 +1,300 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+)
+
+// Backend – способ хранения контактов
+type Backend string
+
+const (
+	// BackendJSON – все контакты в одном json файле
+	BackendJSON Backend = "json"
+)
+
+// Темы, которые можно задать в конфигурации
+const (
+	ThemeSystem = "system"
+	ThemeLight  = "light"
+	ThemeDark   = "dark"
+)
+
+const (
+	appDir         = "contacts"
+	configFileName = "config.json"
+	databaseName   = "database.json"
+
+	// Горизонт панели дней рождения по умолчанию, в днях
+	defaultBirthdayHorizon = 7
+	maxBirthdayHorizon     = 366
+)
+
+// Переменные окружения, перекрывающие файл конфигурации
+const (
+	envConfig          = "CONTACTS_CONFIG"
+	envDatabase        = "CONTACTS_DATABASE"
+	envBackend         = "CONTACTS_BACKEND"
+	envLocale          = "CONTACTS_LOCALE"
+	envTheme           = "CONTACTS_THEME"
+	envBirthdayHorizon = "CONTACTS_BIRTHDAY_HORIZON"
+)
+
+var (
+	ErrUnsupportedBackend = errors.New("unsupported backend")
+	ErrInvalidTheme       = errors.New("invalid theme")
+	ErrInvalidHorizon     = errors.New("invalid birthday horizon")
+)
+
+// LookupEnv – источник переменных окружения, в приложении это os.LookupEnv
+type LookupEnv func(key string) (string, bool)
+
+type Config struct {
+	// DatabasePath – путь к базе контактов
+	DatabasePath string  `json:"database_path"`
+	Backend      Backend `json:"backend"`
+	// Locale – язык интерфейса, пустой – из настроек приложения или системы
+	Locale string `json:"locale"`
+	// Theme – тема при запуске, пустая – из настроек приложения
+	Theme string `json:"theme"`
+	// BirthdayHorizon – за сколько дней вперед панель показывает дни рождения
+	BirthdayHorizon int `json:"birthday_horizon"`
+}
+
+// Load – собирает конфигурацию, каждый следующий источник перекрывает предыдущий:
+//  1. значения по умолчанию
+//  2. файл $XDG_CONFIG_HOME/contacts/config.json или указанный в -config
+//  3. переменные окружения CONTACTS_*
+//  4. флаги командной строки
+//
+// Флаги регистрируются в переданном flags, поэтому точка входа может добавить свои до вызова Load.
+func Load(flags *flag.FlagSet, args []string, lookupEnv LookupEnv) (Config, error) {
+	var (
+		configPath      = flags.String("config", "", "path to config file")
+		databasePath    = flags.String("database", "", "path to contacts database")
+		backend         = flags.String("backend", "", "storage backend: json")
+		locale          = flags.String("locale", "", "interface language, e.g. en or ru")
+		theme           = flags.String("theme", "", "theme: system, light or dark")
+		birthdayHorizon = flags.Int("birthday-horizon", 0, "upcoming birthdays horizon in days")
+	)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return Config{}, fmt.Errorf("parse flags: %w", err)
+	}
+
+	cfg, err := defaults(lookupEnv)
+	if err != nil {
+		return Config{}, err
+	}
+
+	// Файл конфигурации
+	path := *configPath
+	if path == "" {
+		path, _ = lookupEnv(envConfig)
+	}
+	if path == "" {
+		path, err = defaultConfigPath(lookupEnv)
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
+	err = cfg.readFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	// Переменные окружения
+	err = cfg.applyEnv(lookupEnv)
+	if err != nil {
+		return Config{}, err
+	}
+
+	// Флаги, только явно заданные
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "database":
+			cfg.DatabasePath = *databasePath
+		case "backend":
+			cfg.Backend = Backend(*backend)
+		case "locale":
+			cfg.Locale = *locale
+		case "theme":
+			cfg.Theme = *theme
+		case "birthday-horizon":
+			cfg.BirthdayHorizon = *birthdayHorizon
+		}
+	})
+
+	err = cfg.validate()
+	if err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func defaults(lookupEnv LookupEnv) (Config, error) {
+	dataDir, err := dataHome(lookupEnv)
+	if err != nil {
+		return Config{}, err
+	}
+
+	return Config{
+		DatabasePath:    filepath.Join(dataDir, appDir, databaseName),
+		Backend:         BackendJSON,
+		BirthdayHorizon: defaultBirthdayHorizon,
+	}, nil
+}
+
+// readFile – применяет значения из файла, отсутствующий файл не ошибка
+func (c *Config) readFile(path string) error {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	fromFile := *c
+	fromFile.DatabasePath = ""
+
+	err = json.Unmarshal(b, &fromFile)
+	if err != nil {
+		return fmt.Errorf("unmarshal config %s: %w", path, err)
+	}
+
+	// Относительный путь к базе считаем от каталога с конфигурацией, а не от рабочего
+	if fromFile.DatabasePath == "" {
+		fromFile.DatabasePath = c.DatabasePath
+	} else if !filepath.IsAbs(fromFile.DatabasePath) {
+		fromFile.DatabasePath = filepath.Join(filepath.Dir(path), fromFile.DatabasePath)
+	}
+
+	*c = fromFile
+
+	return nil
+}
+
+func (c *Config) applyEnv(lookupEnv LookupEnv) error {
+	if value, ok := lookupEnv(envDatabase); ok && value != "" {
+		c.DatabasePath = value
+	}
+	if value, ok := lookupEnv(envBackend); ok && value != "" {
+		c.Backend = Backend(value)
+	}
+	if value, ok := lookupEnv(envLocale); ok && value != "" {
+		c.Locale = value
+	}
+	if value, ok := lookupEnv(envTheme); ok && value != "" {
+		c.Theme = value
+	}
+	if value, ok := lookupEnv(envBirthdayHorizon); ok && value != "" {
+		horizon, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%w: %s=%q", ErrInvalidHorizon, envBirthdayHorizon, value)
+		}
+		c.BirthdayHorizon = horizon
+	}
+
+	return nil
+}
+
+func (c *Config) validate() error {
+	if c.Backend != BackendJSON {
+		return fmt.Errorf("%w: %q", ErrUnsupportedBackend, c.Backend)
+	}
+
+	if c.Theme != "" && !slices.Contains([]string{ThemeSystem, ThemeLight, ThemeDark}, c.Theme) {
+		return fmt.Errorf("%w: %q", ErrInvalidTheme, c.Theme)
+	}
+
+	if c.BirthdayHorizon < 1 || c.BirthdayHorizon > maxBirthdayHorizon {
+		return fmt.Errorf("%w: %d", ErrInvalidHorizon, c.BirthdayHorizon)
+	}
+
+	return nil
+}
+
+// LegacyDatabasePath – где лежала база до появления конфигурации, относительно рабочего каталога
+const LegacyDatabasePath = "internal/database/database.json"
+
+// MigrateLegacyDatabase – копирует базу из legacyPath на путь по умолчанию, чтобы после обновления контакты не пропали.
+//
+// Копирует, только если путь к базе не переопределен, файла по нему еще нет, а старый файл есть.
+// Старый файл остается на месте. Возвращает true, если база скопирована.
+func MigrateLegacyDatabase(cfg Config, lookupEnv LookupEnv, legacyPath string) (bool, error) {
+	defaultCfg, err := defaults(lookupEnv)
+	if err != nil {
+		return false, err
+	}
+
+	if cfg.DatabasePath != defaultCfg.DatabasePath {
+		return false, nil
+	}
+
+	_, err = os.Stat(cfg.DatabasePath)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("stat database: %w", err)
+	}
+
+	b, err := os.ReadFile(legacyPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("read legacy database: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(cfg.DatabasePath), 0755)
+	if err != nil {
+		return false, fmt.Errorf("create database dir: %w", err)
+	}
+
+	err = os.WriteFile(cfg.DatabasePath, b, 0644)
+	if err != nil {
+		return false, fmt.Errorf("write database: %w", err)
+	}
+
+	return true, nil
+}
+
+// defaultConfigPath – $XDG_CONFIG_HOME/contacts/config.json
+func defaultConfigPath(lookupEnv LookupEnv) (string, error) {
+	dir, ok := lookupEnv("XDG_CONFIG_HOME")
+	if !ok || dir == "" {
+		var err error
+
+		dir, err = os.UserConfigDir()
+		if err != nil {
+			return "", fmt.Errorf("config dir: %w", err)
+		}
+	}
+
+	return filepath.Join(dir, appDir, configFileName), nil
+}
+
+// dataHome – $XDG_DATA_HOME, по умолчанию ~/.local/share
+func dataHome(lookupEnv LookupEnv) (string, error) {
+	if dir, ok := lookupEnv("XDG_DATA_HOME"); ok && dir != "" {
+		return dir, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("home dir: %w", err)
+	}
+
+	return filepath.Join(home, ".local", "share"), nil
+}
@@ -0,0 +1,273 @@
+package config_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "contacts/internal/config"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		file         string
+		env          map[string]string
+		args         []string
+		expectations func(t assert.TestingT, dir string, actual Config, err error)
+	}{
+		{
+			name: "Defaults",
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, Config{
+					DatabasePath:    filepath.Join(dir, "data", "contacts", "database.json"),
+					Backend:         BackendJSON,
+					BirthdayHorizon: 7,
+				}, actual)
+			},
+		},
+		{
+			name: "Config file",
+			file: `{"database_path": "db/contacts.json", "locale": "ru", "theme": "dark", "birthday_horizon": 30}`,
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, Config{
+					// Относительный путь считается от каталога конфигурации
+					DatabasePath:    filepath.Join(dir, "config", "contacts", "db", "contacts.json"),
+					Backend:         BackendJSON,
+					Locale:          "ru",
+					Theme:           ThemeDark,
+					BirthdayHorizon: 30,
+				}, actual)
+			},
+		},
+		{
+			name: "Environment overrides file",
+			file: `{"locale": "ru", "theme": "dark"}`,
+			env: map[string]string{
+				"CONTACTS_LOCALE":           "en",
+				"CONTACTS_DATABASE":         "/tmp/contacts.json",
+				"CONTACTS_BIRTHDAY_HORIZON": "14",
+			},
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "en", actual.Locale)
+				assert.Equal(t, ThemeDark, actual.Theme)
+				assert.Equal(t, "/tmp/contacts.json", actual.DatabasePath)
+				assert.Equal(t, 14, actual.BirthdayHorizon)
+			},
+		},
+		{
+			name: "Flags override environment",
+			env: map[string]string{
+				"CONTACTS_LOCALE": "en",
+				"CONTACTS_THEME":  "dark",
+			},
+			args: []string{"-locale", "ru", "-database", "contacts.json"},
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "ru", actual.Locale)
+				assert.Equal(t, ThemeDark, actual.Theme)
+				assert.Equal(t, "contacts.json", actual.DatabasePath)
+			},
+		},
+		{
+			name: "Unsupported backend",
+			args: []string{"-backend", "sqlite"},
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.ErrorIs(t, err, ErrUnsupportedBackend)
+			},
+		},
+		{
+			name: "Invalid theme",
+			file: `{"theme": "pink"}`,
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.ErrorIs(t, err, ErrInvalidTheme)
+			},
+		},
+		{
+			name: "Invalid horizon in environment",
+			env: map[string]string{
+				"CONTACTS_BIRTHDAY_HORIZON": "week",
+			},
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.ErrorIs(t, err, ErrInvalidHorizon)
+			},
+		},
+		{
+			name: "Horizon out of range",
+			args: []string{"-birthday-horizon", "0"},
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.ErrorIs(t, err, ErrInvalidHorizon)
+			},
+		},
+		{
+			name: "Broken config file",
+			file: `{"locale": `,
+			expectations: func(t assert.TestingT, dir string, actual Config, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			env := map[string]string{
+				"XDG_CONFIG_HOME": filepath.Join(dir, "config"),
+				"XDG_DATA_HOME":   filepath.Join(dir, "data"),
+			}
+			for key, value := range tc.env {
+				env[key] = value
+			}
+
+			if tc.file != "" {
+				path := filepath.Join(dir, "config", "contacts", "config.json")
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte(tc.file), 0644))
+			}
+
+			lookupEnv := func(key string) (string, bool) {
+				value, ok := env[key]
+				return value, ok
+			}
+
+			actual, err := Load(flag.NewFlagSet("contacts", flag.ContinueOnError), tc.args, lookupEnv)
+
+			tc.expectations(t, dir, actual, err)
+		})
+	}
+}
+
+func TestLoad_ConfigFlag(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "custom.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"locale": "ru"}`), 0644))
+
+	lookupEnv := func(key string) (string, bool) {
+		if key == "XDG_DATA_HOME" {
+			return dir, true
+		}
+		return "", false
+	}
+
+	actual, err := Load(flag.NewFlagSet("contacts", flag.ContinueOnError), []string{"-config", path}, lookupEnv)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ru", actual.Locale)
+}
+
+func TestMigrateLegacyDatabase(t *testing.T) {
+	t.Parallel()
+
+	const legacy = `{"1": {"uuid": "1", "name": "Иван"}}`
+
+	tests := []struct {
+		name         string
+		legacy       bool
+		current      string
+		databasePath string
+		expectations func(t assert.TestingT, dir string, migrated bool, err error)
+	}{
+		{
+			name:   "Legacy database is copied to the default path",
+			legacy: true,
+			expectations: func(t assert.TestingT, dir string, migrated bool, err error) {
+				assert.NoError(t, err)
+				assert.True(t, migrated)
+
+				b, readErr := os.ReadFile(filepath.Join(dir, "data", "contacts", "database.json"))
+				assert.NoError(t, readErr)
+				assert.Equal(t, legacy, string(b))
+
+				// Старый файл остается на месте
+				assert.FileExists(t, filepath.Join(dir, "legacy", "database.json"))
+			},
+		},
+		{
+			name: "No legacy database",
+			expectations: func(t assert.TestingT, dir string, migrated bool, err error) {
+				assert.NoError(t, err)
+				assert.False(t, migrated)
+				assert.NoFileExists(t, filepath.Join(dir, "data", "contacts", "database.json"))
+			},
+		},
+		{
+			name:    "Existing database is not overwritten",
+			legacy:  true,
+			current: `{}`,
+			expectations: func(t assert.TestingT, dir string, migrated bool, err error) {
+				assert.NoError(t, err)
+				assert.False(t, migrated)
+
+				b, readErr := os.ReadFile(filepath.Join(dir, "data", "contacts", "database.json"))
+				assert.NoError(t, readErr)
+				assert.Equal(t, `{}`, string(b))
+			},
+		},
+		{
+			name:         "Database path set explicitly",
+			legacy:       true,
+			databasePath: "custom.json",
+			expectations: func(t assert.TestingT, dir string, migrated bool, err error) {
+				assert.NoError(t, err)
+				assert.False(t, migrated)
+				assert.NoFileExists(t, filepath.Join(dir, "custom.json"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			env := map[string]string{
+				"XDG_CONFIG_HOME": filepath.Join(dir, "config"),
+				"XDG_DATA_HOME":   filepath.Join(dir, "data"),
+			}
+			if tc.databasePath != "" {
+				env["CONTACTS_DATABASE"] = filepath.Join(dir, tc.databasePath)
+			}
+
+			lookupEnv := func(key string) (string, bool) {
+				value, ok := env[key]
+				return value, ok
+			}
+
+			legacyPath := filepath.Join(dir, "legacy", "database.json")
+			if tc.legacy {
+				require.NoError(t, os.MkdirAll(filepath.Dir(legacyPath), 0755))
+				require.NoError(t, os.WriteFile(legacyPath, []byte(legacy), 0644))
+			}
+
+			if tc.current != "" {
+				path := filepath.Join(dir, "data", "contacts", "database.json")
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte(tc.current), 0644))
+			}
+
+			cfg, err := Load(flag.NewFlagSet("contacts", flag.ContinueOnError), nil, lookupEnv)
+			require.NoError(t, err)
+
+			migrated, err := MigrateLegacyDatabase(cfg, lookupEnv, legacyPath)
+
+			tc.expectations(t, dir, migrated, err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package avatar
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/jpeg" // Регистрируем декодер JPEG
+	"image/png"
+
+	"github.com/nfnt/resize"
+)
+
+// DefaultSize – сторона миниатюры аватара в пикселях
+const DefaultSize = 256
+
+type Thumbnailer struct {
+	size uint
+}
+
+func NewThumbnailer(size uint) *Thumbnailer {
+	return &Thumbnailer{
+		size: size,
+	}
+}
+
+// Thumbnail – обрезает изображение (PNG или JPEG) до квадрата по центру
+// и уменьшает его до размера миниатюры.
+//
+// Результат всегда кодируется в PNG.
+func (t *Thumbnailer) Thumbnail(data []byte) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+
+	thumbnail := resize.Resize(t.size, t.size, crop(img), resize.Lanczos3)
+
+	var buf bytes.Buffer
+	err = png.Encode(&buf, thumbnail)
+	if err != nil {
+		return nil, fmt.Errorf("encode: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// crop – вырезает из изображения квадрат максимального размера по центру
+func crop(img image.Image) image.Image {
+	bounds := img.Bounds()
+
+	side := min(bounds.Dx(), bounds.Dy())
+
+	x := bounds.Min.X + (bounds.Dx()-side)/2
+	y := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	square := image.Rect(x, y, x+side, y+side)
+
+	type subImager interface {
+		SubImage(r image.Rectangle) image.Image
+	}
+
+	if sub, ok := img.(subImager); ok {
+		return sub.SubImage(square)
+	}
+
+	// Для экзотических реализаций копируем пиксели вручную
+	cropped := image.NewRGBA(image.Rect(0, 0, side, side))
+	for i := 0; i < side; i++ {
+		for j := 0; j < side; j++ {
+			cropped.Set(i, j, img.At(x+i, y+j))
+		}
+	}
+
+	return cropped
+}
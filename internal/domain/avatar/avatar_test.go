@@ -0,0 +1,99 @@
+package avatar_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "contacts/internal/domain/avatar"
+)
+
+func TestThumbnailer_Thumbnail(t *testing.T) {
+	t.Parallel()
+
+	// Горизонтальное изображение: слева красная полоса, по центру синий квадрат, справа красная полоса
+	source := image.NewRGBA(image.Rect(0, 0, 300, 100))
+	for x := 0; x < 300; x++ {
+		for y := 0; y < 100; y++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 100 && x < 200 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			source.Set(x, y, c)
+		}
+	}
+
+	encodePng := func() []byte {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, source))
+		return buf.Bytes()
+	}
+
+	encodeJpeg := func() []byte {
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, source, nil))
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name         string
+		data         []byte
+		expectations func(t assert.TestingT, actual []byte, err error)
+	}{
+		{
+			name: "Not an image",
+			data: []byte("hello"),
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "PNG",
+			data: encodePng(),
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.NoError(t, err)
+
+				img, err := png.Decode(bytes.NewReader(actual))
+				assert.NoError(t, err)
+
+				// Миниатюра квадратная и содержит только центральную часть
+				assert.Equal(t, image.Rect(0, 0, 32, 32), img.Bounds())
+
+				r, _, b, _ := img.At(16, 16).RGBA()
+				assert.Zero(t, r)
+				assert.NotZero(t, b)
+			},
+		},
+		{
+			name: "JPEG",
+			data: encodeJpeg(),
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.NoError(t, err)
+
+				img, err := png.Decode(bytes.NewReader(actual))
+				assert.NoError(t, err)
+				assert.Equal(t, image.Rect(0, 0, 32, 32), img.Bounds())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewThumbnailer(32)
+
+			out, err := instance.Thumbnail(tc.data)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package date
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// UnknownYear – год даты, у которой известны только день и месяц.
+//
+// Нулевой год високосный, поэтому 29 февраля без года тоже допустимо.
+const UnknownYear = 0
+
+var ErrFormat = errors.New("invalid date format")
+
+// Форматы, в которых принимаем дату независимо от языка.
+// Разделитель однозначно определяет порядок дня и месяца.
+var (
+	fullLayouts = []string{
+		"2006-01-02", // ISO
+		"2.1.2006",   // 02.01.2006
+		"1/2/2006",   // 01/02/2006
+	}
+	partialLayouts = []string{
+		"--01-02", // ISO без года, как в vCard
+		"2.1",     // 02.01
+		"1/2",     // 01/02
+	}
+)
+
+// Форматы отображения для языков интерфейса
+type layout struct {
+	full     string
+	partial  string
+	dateTime string
+}
+
+var (
+	defaultLayout = layout{full: "2006-01-02", partial: "--01-02", dateTime: "2006-01-02 15:04"}
+	localeLayouts = map[string]layout{
+		"ru": {full: "02.01.2006", partial: "02.01", dateTime: "02.01.2006 15:04"},
+		"en": {full: "01/02/2006", partial: "01/02", dateTime: "01/02/2006 3:04 PM"},
+	}
+)
+
+// Formatter – разбор и отображение дат с учетом языка пользователя
+type Formatter struct {
+	layout layout
+}
+
+// NewFormatter – форматтер для языка, например "ru" или "en-US".
+//
+// Для неизвестного языка даты показываются в ISO.
+func NewFormatter(locale string) *Formatter {
+	base, _, _ := strings.Cut(strings.ToLower(locale), "-")
+
+	l, ok := localeLayouts[base]
+	if !ok {
+		l = defaultLayout
+	}
+
+	return &Formatter{
+		layout: l,
+	}
+}
+
+// Parse – дата в любом из поддерживаемых форматов.
+//
+// Для даты без года возвращается дата в UnknownYear.
+func (f *Formatter) Parse(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	for _, l := range fullLayouts {
+		t, err := time.Parse(l, value)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	for _, l := range partialLayouts {
+		t, err := time.Parse(l, value)
+		if err == nil {
+			return time.Date(UnknownYear, t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+
+	return time.Time{}, ErrFormat
+}
+
+// Format – дата в формате языка, без года, если он неизвестен
+func (f *Formatter) Format(t time.Time) string {
+	if !HasYear(t) {
+		return t.Format(f.layout.partial)
+	}
+
+	return t.Format(f.layout.full)
+}
+
+// FormatShort – только день и месяц, например для ближайших дней рождения
+func (f *Formatter) FormatShort(t time.Time) string {
+	return t.Format(f.layout.partial)
+}
+
+// FormatDateTime – дата и время в формате языка, например для даты изменения контакта.
+//
+// Часовой пояс не меняется, для местного времени передавайте t.Local().
+func (f *Formatter) FormatDateTime(t time.Time) string {
+	return t.Format(f.layout.dateTime)
+}
+
+// Example – подсказка с форматом даты для полей ввода
+func (f *Formatter) Example() string {
+	return time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC).Format(f.layout.full)
+}
+
+// HasYear – известен ли год даты
+func HasYear(t time.Time) bool {
+	return t.Year() != UnknownYear
+}
@@ -0,0 +1,155 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "contacts/internal/domain/date"
+)
+
+func TestFormatter_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		value        string
+		expectations func(t assert.TestingT, actual time.Time, err error)
+	}{
+		{
+			name:  "ISO",
+			value: "2001-01-10",
+			expectations: func(t assert.TestingT, actual time.Time, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC), actual)
+			},
+		},
+		{
+			name:  "Day first with dots",
+			value: "10.01.2001",
+			expectations: func(t assert.TestingT, actual time.Time, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC), actual)
+			},
+		},
+		{
+			name:  "Month first with slashes",
+			value: "01/10/2001",
+			expectations: func(t assert.TestingT, actual time.Time, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC), actual)
+			},
+		},
+		{
+			name:  "Without year",
+			value: "10.01",
+			expectations: func(t assert.TestingT, actual time.Time, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, time.Date(UnknownYear, time.January, 10, 0, 0, 0, 0, time.UTC), actual)
+				assert.False(t, HasYear(actual))
+			},
+		},
+		{
+			name:  "29 February without year",
+			value: "--02-29",
+			expectations: func(t assert.TestingT, actual time.Time, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, time.Date(UnknownYear, time.February, 29, 0, 0, 0, 0, time.UTC), actual)
+			},
+		},
+		{
+			name:  "Invalid",
+			value: "10-01-2001",
+			expectations: func(t assert.TestingT, actual time.Time, err error) {
+				assert.ErrorIs(t, err, ErrFormat)
+			},
+		},
+		{
+			name:  "Non-existent day",
+			value: "31.02.2001",
+			expectations: func(t assert.TestingT, actual time.Time, err error) {
+				assert.ErrorIs(t, err, ErrFormat)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := NewFormatter("ru").Parse(tc.value)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
+
+func TestFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	full := time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC)
+	partial := time.Date(UnknownYear, time.January, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		locale   string
+		date     time.Time
+		expected string
+	}{
+		{name: "ru", locale: "ru", date: full, expected: "10.01.2001"},
+		{name: "ru without year", locale: "ru", date: partial, expected: "10.01"},
+		{name: "en", locale: "en-US", date: full, expected: "01/10/2001"},
+		{name: "en without year", locale: "en", date: partial, expected: "01/10"},
+		{name: "Unknown locale", locale: "de", date: full, expected: "2001-01-10"},
+		{name: "Unknown locale without year", locale: "de", date: partial, expected: "--01-10"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter := NewFormatter(tc.locale)
+
+			out := formatter.Format(tc.date)
+			assert.Equal(t, tc.expected, out)
+
+			// Отображаемая дата разбирается обратно
+			parsed, err := formatter.Parse(out)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.date, parsed)
+		})
+	}
+}
+
+func TestFormatter_FormatDateTime(t *testing.T) {
+	t.Parallel()
+
+	value := time.Date(2026, time.October, 19, 14, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		locale   string
+		expected string
+	}{
+		{name: "ru", locale: "ru", expected: "19.10.2026 14:05"},
+		{name: "en", locale: "en-US", expected: "10/19/2026 2:05 PM"},
+		{name: "Unknown locale", locale: "de", expected: "2026-10-19 14:05"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			formatter := NewFormatter(tc.locale)
+
+			assert.Equal(t, tc.expected, formatter.FormatDateTime(value))
+		})
+	}
+}
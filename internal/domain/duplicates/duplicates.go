@@ -0,0 +1,142 @@
+package duplicates
+
+import (
+	"sort"
+	"strings"
+
+	"contacts/internal/model"
+)
+
+const (
+	// Веса признаков, в сумме дают 1
+	phoneWeight = 0.35
+	emailWeight = 0.35
+	nameWeight  = 0.3
+
+	// Начиная с какой похожести имена считаются совпавшими
+	nameMatchThreshold = 0.8
+
+	// DefaultThreshold – минимальная оценка, начиная с которой пара считается дубликатом
+	DefaultThreshold = 0.5
+)
+
+type Finder struct {
+	threshold float64
+}
+
+func NewFinder(threshold float64) *Finder {
+	return &Finder{
+		threshold: threshold,
+	}
+}
+
+// Find – находит пары контактов, похожих на одного человека.
+//
+// Пары отсортированы по убыванию оценки.
+func (f *Finder) Find(contacts []model.Contact) []model.DuplicatePair {
+	pairs := make([]model.DuplicatePair, 0)
+
+	for i := 0; i < len(contacts); i++ {
+		for j := i + 1; j < len(contacts); j++ {
+			pair := Score(contacts[i], contacts[j])
+			if pair.Score < f.threshold {
+				continue
+			}
+
+			pairs = append(pairs, pair)
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Score > pairs[j].Score
+	})
+
+	return pairs
+}
+
+// Score – оценивает похожесть двух контактов по телефону, email и ФИО
+func Score(first, second model.Contact) model.DuplicatePair {
+	pair := model.DuplicatePair{
+		First:   first,
+		Second:  second,
+		Matches: make([]model.Field, 0),
+	}
+
+	if first.Phone.Number() != 0 && first.Phone.Number() == second.Phone.Number() {
+		pair.Score += phoneWeight
+		pair.Matches = append(pair.Matches, model.FieldPhone)
+	}
+
+	firstEmail := normalizeEmail(first.Email)
+	if firstEmail != "" && firstEmail == normalizeEmail(second.Email) {
+		pair.Score += emailWeight
+		pair.Matches = append(pair.Matches, model.FieldEmail)
+	}
+
+	surnameSimilarity := similarity(normalizeName(first.Surname), normalizeName(second.Surname))
+	if surnameSimilarity >= nameMatchThreshold {
+		pair.Matches = append(pair.Matches, model.FieldSurname)
+	}
+
+	nameSimilarity := similarity(normalizeName(first.Name), normalizeName(second.Name))
+	if nameSimilarity >= nameMatchThreshold {
+		pair.Matches = append(pair.Matches, model.FieldName)
+	}
+
+	pair.Score += nameWeight * (surnameSimilarity + nameSimilarity) / 2
+
+	return pair
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// normalizeName – приводит имя к нижнему регистру и не различает е и ё
+func normalizeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	return strings.ReplaceAll(name, "ё", "е")
+}
+
+// similarity – похожесть строк от 0 до 1 на основе расстояния Левенштейна
+func similarity(a, b string) float64 {
+	first, second := []rune(a), []rune(b)
+
+	longest := max(len(first), len(second))
+	if longest == 0 {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(first, second))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(
+				previous[j]+1,      // Удаление
+				current[j-1]+1,     // Вставка
+				previous[j-1]+cost, // Замена
+			)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,144 @@
+package duplicates_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "contacts/internal/domain/duplicates"
+	"contacts/internal/model"
+)
+
+func TestFinder_Find(t *testing.T) {
+	t.Parallel()
+
+	ershov := model.Contact{
+		UUID:    "1",
+		Surname: "Ершов",
+		Name:    "Виталий",
+		Phone:   model.NewPhoneFromInt64(79151596781),
+		Email:   "vaershov@avito.ru",
+	}
+
+	// Тот же человек, записанный через е и с email в другом регистре
+	ershovCopy := model.Contact{
+		UUID:    "2",
+		Surname: "Ершов",
+		Name:    "Виталии",
+		Phone:   model.NewPhoneFromInt64(79151596781),
+		Email:   " VAErshov@avito.ru",
+	}
+
+	zaitsev := model.Contact{
+		UUID:    "3",
+		Surname: "Зайцев",
+		Name:    "Сергей",
+		Phone:   model.NewPhoneFromInt64(79165765731),
+		Email:   "zaitsev@avito.ru",
+	}
+
+	tests := []struct {
+		name         string
+		contacts     []model.Contact
+		expectations func(t assert.TestingT, actual []model.DuplicatePair)
+	}{
+		{
+			name:     "No contacts",
+			contacts: nil,
+			expectations: func(t assert.TestingT, actual []model.DuplicatePair) {
+				assert.Empty(t, actual)
+			},
+		},
+		{
+			name:     "No duplicates",
+			contacts: []model.Contact{ershov, zaitsev},
+			expectations: func(t assert.TestingT, actual []model.DuplicatePair) {
+				assert.Empty(t, actual)
+			},
+		},
+		{
+			name:     "Duplicates found",
+			contacts: []model.Contact{ershov, zaitsev, ershovCopy},
+			expectations: func(t assert.TestingT, actual []model.DuplicatePair) {
+				if !assert.Len(t, actual, 1) {
+					return
+				}
+
+				assert.Equal(t, ershov, actual[0].First)
+				assert.Equal(t, ershovCopy, actual[0].Second)
+				assert.InDelta(t, 0.98, actual[0].Score, 0.01)
+				assert.Equal(t, []model.Field{
+					model.FieldPhone,
+					model.FieldEmail,
+					model.FieldSurname,
+					model.FieldName,
+				}, actual[0].Matches)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewFinder(DefaultThreshold)
+
+			out := instance.Find(tc.contacts)
+
+			tc.expectations(t, out)
+		})
+	}
+}
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		first        model.Contact
+		second       model.Contact
+		expectations func(t assert.TestingT, actual model.DuplicatePair)
+	}{
+		{
+			name:   "Empty contacts are not similar",
+			first:  model.Contact{UUID: "1"},
+			second: model.Contact{UUID: "2"},
+			expectations: func(t assert.TestingT, actual model.DuplicatePair) {
+				assert.Zero(t, actual.Score)
+				assert.Empty(t, actual.Matches)
+			},
+		},
+		{
+			name:   "Е and Ё are equal",
+			first:  model.Contact{Surname: "Королёв", Name: "Сергей"},
+			second: model.Contact{Surname: "Королев", Name: "Сергей"},
+			expectations: func(t assert.TestingT, actual model.DuplicatePair) {
+				assert.InDelta(t, 0.3, actual.Score, 0.001)
+				assert.Equal(t, []model.Field{model.FieldSurname, model.FieldName}, actual.Matches)
+			},
+		},
+		{
+			name:   "Only phone matches",
+			first:  model.Contact{Surname: "Иванов", Phone: model.NewPhoneFromInt64(79151596781)},
+			second: model.Contact{Surname: "Петров", Phone: model.NewPhoneFromInt64(79151596781)},
+			expectations: func(t assert.TestingT, actual model.DuplicatePair) {
+				assert.Equal(t, []model.Field{model.FieldPhone}, actual.Matches)
+				assert.Less(t, actual.Score, DefaultThreshold)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out := Score(tc.first, tc.second)
+
+			tc.expectations(t, out)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package match
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Range – совпавший фрагмент текста, границы в байтах: text[Start:End]
+type Range struct {
+	Start int
+	End   int
+}
+
+// Ranges – фрагменты text, совпавшие со словами поискового запроса, без учета регистра.
+//
+// Слова ищутся так же, как при поиске контактов: каждое отдельно, в любом месте текста.
+// Пересекающиеся и соседние фрагменты объединяются, результат упорядочен по началу.
+func Ranges(text, query string) []Range {
+	runes := []rune(text)
+	matched := make([]bool, len(runes))
+
+	for _, word := range strings.Fields(query) {
+		wordRunes := []rune(word)
+
+		for start := 0; start+len(wordRunes) <= len(runes); start++ {
+			if equalFold(runes[start:start+len(wordRunes)], wordRunes) {
+				for i := start; i < start+len(wordRunes); i++ {
+					matched[i] = true
+				}
+			}
+		}
+	}
+
+	ranges := make([]Range, 0)
+	offset := 0
+	for i, r := range runes {
+		size := utf8.RuneLen(r)
+
+		if matched[i] {
+			if last := len(ranges) - 1; last >= 0 && ranges[last].End == offset {
+				ranges[last].End += size
+			} else {
+				ranges = append(ranges, Range{Start: offset, End: offset + size})
+			}
+		}
+
+		offset += size
+	}
+
+	return ranges
+}
+
+func equalFold(a, b []rune) bool {
+	for i := range a {
+		if unicode.ToLower(a[i]) != unicode.ToLower(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,80 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "contacts/internal/domain/match"
+)
+
+func TestRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		text         string
+		query        string
+		expectations func(t assert.TestingT, text string, actual []Range)
+	}{
+		{
+			name:  "Empty query",
+			text:  "Ершов Вадим",
+			query: "  ",
+			expectations: func(t assert.TestingT, text string, actual []Range) {
+				assert.Empty(t, actual)
+			},
+		},
+		{
+			name:  "No match",
+			text:  "Ершов Вадим",
+			query: "Иванов",
+			expectations: func(t assert.TestingT, text string, actual []Range) {
+				assert.Empty(t, actual)
+			},
+		},
+		{
+			name:  "Case insensitive, byte offsets",
+			text:  "Ершов Вадим",
+			query: "вад",
+			expectations: func(t assert.TestingT, text string, actual []Range) {
+				if assert.Len(t, actual, 1) {
+					assert.Equal(t, "Вад", text[actual[0].Start:actual[0].End])
+				}
+			},
+		},
+		{
+			name:  "Every word and every occurrence",
+			text:  "Анна Ананьева",
+			query: "ан ева",
+			expectations: func(t assert.TestingT, text string, actual []Range) {
+				fragments := make([]string, 0, len(actual))
+				for _, r := range actual {
+					fragments = append(fragments, text[r.Start:r.End])
+				}
+
+				assert.Equal(t, []string{"Ан", "Анан", "ева"}, fragments)
+			},
+		},
+		{
+			name:  "Overlapping words are merged",
+			text:  "Ершов",
+			query: "ерш шов",
+			expectations: func(t assert.TestingT, text string, actual []Range) {
+				assert.Equal(t, []Range{{Start: 0, End: len("Ершов")}}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := Ranges(tc.text, tc.query)
+
+			tc.expectations(t, tc.text, actual)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package order
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+
+	"contacts/internal/model"
+)
+
+// NoGroup – группа контактов без значения поля, например без даты рождения
+const NoGroup = ""
+
+// MonthLayout – формат группы для дат добавления и изменения
+const MonthLayout = "2006-01"
+
+// OtherGroup – группа имен, которые начинаются не с буквы
+const OtherGroup = "#"
+
+// Sorter – упорядочивает контакты по правилам языка, а не по кодам символов.
+//
+// Безопасен для одновременного использования из нескольких горутин.
+type Sorter struct {
+	// collator хранит промежуточные буферы, поэтому сравнения идут по очереди
+	mu       sync.Mutex
+	collator *collate.Collator
+}
+
+func NewSorter(tag language.Tag) *Sorter {
+	return &Sorter{
+		collator: collate.New(tag),
+	}
+}
+
+// Sort – сортирует контакты по ключу.
+//
+// При равенстве сравниваются фамилия и имя, в конце – UUID, чтобы порядок не менялся между загрузками.
+func (s *Sorter) Sort(contacts []model.Contact, key model.SortKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sort.SliceStable(contacts, func(i, j int) bool {
+		return s.compare(contacts[i], contacts[j], key) < 0
+	})
+}
+
+// SortOrganizations – сортирует организации по названию, при равенстве – по UUID
+func (s *Sorter) SortOrganizations(organizations []model.Organization) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sort.SliceStable(organizations, func(i, j int) bool {
+		if result := s.collator.CompareString(organizations[i].Name, organizations[j].Name); result != 0 {
+			return result < 0
+		}
+
+		return organizations[i].UUID < organizations[j].UUID
+	})
+}
+
+func (s *Sorter) compare(a, b model.Contact, key model.SortKey) int {
+	var steps []func() int
+
+	switch key {
+	case model.SortKeyName:
+		steps = []func() int{
+			func() int { return s.collator.CompareString(a.Name, b.Name) },
+			func() int { return s.collator.CompareString(a.Surname, b.Surname) },
+		}
+	case model.SortKeyBirthday:
+		steps = []func() int{
+			func() int { return compareBirthday(a, b) },
+			func() int { return s.collator.CompareString(a.Surname, b.Surname) },
+			func() int { return s.collator.CompareString(a.Name, b.Name) },
+		}
+	case model.SortKeyCreated:
+		steps = []func() int{
+			func() int { return compareNewest(a.CreatedAt, b.CreatedAt) },
+			func() int { return s.collator.CompareString(a.Surname, b.Surname) },
+			func() int { return s.collator.CompareString(a.Name, b.Name) },
+		}
+	case model.SortKeyUpdated:
+		steps = []func() int{
+			func() int { return compareNewest(a.UpdatedAt, b.UpdatedAt) },
+			func() int { return s.collator.CompareString(a.Surname, b.Surname) },
+			func() int { return s.collator.CompareString(a.Name, b.Name) },
+		}
+	default:
+		steps = []func() int{
+			func() int { return s.collator.CompareString(a.Surname, b.Surname) },
+			func() int { return s.collator.CompareString(a.Name, b.Name) },
+		}
+	}
+
+	for _, step := range steps {
+		if result := step(); result != 0 {
+			return result
+		}
+	}
+
+	return strings.Compare(a.UUID, b.UUID)
+}
+
+// compareBirthday – по дню в году, год рождения не важен
+func compareBirthday(a, b model.Contact) int {
+	switch {
+	case a.Birthday.IsZero() && b.Birthday.IsZero():
+		return 0
+	case a.Birthday.IsZero():
+		return 1
+	case b.Birthday.IsZero():
+		return -1
+	}
+
+	if result := int(a.Birthday.Month()) - int(b.Birthday.Month()); result != 0 {
+		return result
+	}
+
+	return a.Birthday.Day() - b.Birthday.Day()
+}
+
+// compareNewest – сначала более поздние даты, неизвестные – в конце
+func compareNewest(a, b time.Time) int {
+	switch {
+	case a.IsZero() && b.IsZero():
+		return 0
+	case a.IsZero():
+		return 1
+	case b.IsZero():
+		return -1
+	}
+
+	return b.Compare(a)
+}
+
+// Group – заголовок группы, в которую попадает контакт в списке.
+//
+// Для фамилии и имени это первая буква, Ё входит в группу Е, как и при сортировке.
+// Для дня рождения – номер месяца, без даты – NoGroup.
+// Для дат добавления и изменения – месяц с годом в виде "2006-01", без даты – NoGroup.
+func (s *Sorter) Group(contact model.Contact, key model.SortKey) string {
+	switch key {
+	case model.SortKeyCreated:
+		return monthOf(contact.CreatedAt)
+	case model.SortKeyUpdated:
+		return monthOf(contact.UpdatedAt)
+	case model.SortKeyBirthday:
+		if contact.Birthday.IsZero() {
+			return NoGroup
+		}
+
+		return strconv.Itoa(int(contact.Birthday.Month()))
+	case model.SortKeyName:
+		return letter(contact.Name)
+	default:
+		return letter(contact.Surname)
+	}
+}
+
+func monthOf(t time.Time) string {
+	if t.IsZero() {
+		return NoGroup
+	}
+
+	return t.Format(MonthLayout)
+}
+
+func letter(value string) string {
+	for _, r := range strings.TrimSpace(value) {
+		if !unicode.IsLetter(r) {
+			return OtherGroup
+		}
+
+		r = unicode.ToUpper(r)
+		if r == 'Ё' {
+			r = 'Е'
+		}
+
+		return string(r)
+	}
+
+	return OtherGroup
+}
+
+// Alphabet – буквы русского алфавита для указателя, Ё входит в Е
+func (s *Sorter) Alphabet() []string {
+	return strings.Split("АБВГДЕЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ", "")
+}
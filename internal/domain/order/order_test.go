@@ -0,0 +1,273 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	. "contacts/internal/domain/order"
+	"contacts/internal/model"
+)
+
+func TestSorter_Sort(t *testing.T) {
+	t.Parallel()
+
+	birthday := func(month time.Month, day int) time.Time {
+		return time.Date(1990, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	uuids := func(contacts []model.Contact) []string {
+		result := make([]string, 0, len(contacts))
+		for _, contact := range contacts {
+			result = append(result, contact.UUID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name         string
+		contacts     []model.Contact
+		key          model.SortKey
+		expectations func(t assert.TestingT, actual []model.Contact)
+	}{
+		{
+			name: "Ё sorts with Е, not after Я",
+			contacts: []model.Contact{
+				{UUID: "1", Surname: "Яковлев"},
+				{UUID: "2", Surname: "Ёлкин"},
+				{UUID: "3", Surname: "Жуков"},
+				{UUID: "4", Surname: "Егоров"},
+			},
+			key: model.SortKeySurname,
+			expectations: func(t assert.TestingT, actual []model.Contact) {
+				assert.Equal(t, []string{"4", "2", "3", "1"}, uuids(actual))
+			},
+		},
+		{
+			name: "Case doesn't split letters",
+			contacts: []model.Contact{
+				{UUID: "1", Surname: "борисов"},
+				{UUID: "2", Surname: "Алексеев"},
+				{UUID: "3", Surname: "Белов"},
+			},
+			key: model.SortKeySurname,
+			expectations: func(t assert.TestingT, actual []model.Contact) {
+				assert.Equal(t, []string{"2", "3", "1"}, uuids(actual))
+			},
+		},
+		{
+			name: "Same surname, tie-break by name and uuid",
+			contacts: []model.Contact{
+				{UUID: "3", Surname: "Ершов", Name: "Вадим"},
+				{UUID: "2", Surname: "Ершов", Name: "Вадим"},
+				{UUID: "1", Surname: "Ершов", Name: "Анна"},
+			},
+			key: model.SortKeySurname,
+			expectations: func(t assert.TestingT, actual []model.Contact) {
+				assert.Equal(t, []string{"1", "2", "3"}, uuids(actual))
+			},
+		},
+		{
+			name: "By name, tie-break by surname",
+			contacts: []model.Contact{
+				{UUID: "1", Surname: "Петров", Name: "Иван"},
+				{UUID: "2", Surname: "Иванов", Name: "Иван"},
+				{UUID: "3", Surname: "Яшин", Name: "Алла"},
+			},
+			key: model.SortKeyName,
+			expectations: func(t assert.TestingT, actual []model.Contact) {
+				assert.Equal(t, []string{"3", "2", "1"}, uuids(actual))
+			},
+		},
+		{
+			name: "By birthday day of year, without date last",
+			contacts: []model.Contact{
+				{UUID: "1", Surname: "Без даты"},
+				{UUID: "2", Surname: "Декабрь", Birthday: birthday(time.December, 1)},
+				{UUID: "3", Surname: "Март", Birthday: time.Date(2001, time.March, 5, 0, 0, 0, 0, time.UTC)},
+				{UUID: "4", Surname: "Март ранний", Birthday: birthday(time.March, 2)},
+			},
+			key: model.SortKeyBirthday,
+			expectations: func(t assert.TestingT, actual []model.Contact) {
+				assert.Equal(t, []string{"4", "3", "2", "1"}, uuids(actual))
+			},
+		},
+		{
+			name: "By date added, newest first, unknown last",
+			contacts: []model.Contact{
+				{UUID: "1", Surname: "Без даты"},
+				{UUID: "2", Surname: "Старый", CreatedAt: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+				{UUID: "3", Surname: "Новый", CreatedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)},
+			},
+			key: model.SortKeyCreated,
+			expectations: func(t assert.TestingT, actual []model.Contact) {
+				assert.Equal(t, []string{"3", "2", "1"}, uuids(actual))
+			},
+		},
+		{
+			name: "By date updated, same date tie-break by surname",
+			contacts: []model.Contact{
+				{UUID: "1", Surname: "Яшин", UpdatedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)},
+				{UUID: "2", Surname: "Агеев", UpdatedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)},
+				{UUID: "3", Surname: "Борисов", UpdatedAt: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			key: model.SortKeyUpdated,
+			expectations: func(t assert.TestingT, actual []model.Contact) {
+				assert.Equal(t, []string{"2", "1", "3"}, uuids(actual))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewSorter(language.Russian)
+
+			instance.Sort(tc.contacts, tc.key)
+
+			tc.expectations(t, tc.contacts)
+		})
+	}
+}
+
+func TestSorter_SortOrganizations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		organizations []model.Organization
+		expectations  func(t assert.TestingT, actual []model.Organization)
+	}{
+		{
+			name: "Russian alphabet, case doesn't matter",
+			organizations: []model.Organization{
+				{UUID: "1", Name: "Яндекс"},
+				{UUID: "2", Name: "ёлка"},
+				{UUID: "3", Name: "Авито"},
+				{UUID: "4", Name: "Жилстрой"},
+			},
+			expectations: func(t assert.TestingT, actual []model.Organization) {
+				assert.Equal(t, []model.Organization{
+					{UUID: "3", Name: "Авито"},
+					{UUID: "2", Name: "ёлка"},
+					{UUID: "4", Name: "Жилстрой"},
+					{UUID: "1", Name: "Яндекс"},
+				}, actual)
+			},
+		},
+		{
+			name: "Same name tie-break by UUID",
+			organizations: []model.Organization{
+				{UUID: "2", Name: "Авито"},
+				{UUID: "1", Name: "Авито"},
+			},
+			expectations: func(t assert.TestingT, actual []model.Organization) {
+				assert.Equal(t, []model.Organization{
+					{UUID: "1", Name: "Авито"},
+					{UUID: "2", Name: "Авито"},
+				}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewSorter(language.Russian)
+
+			instance.SortOrganizations(tc.organizations)
+
+			tc.expectations(t, tc.organizations)
+		})
+	}
+}
+
+func TestSorter_Group(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		contact      model.Contact
+		key          model.SortKey
+		expectations func(t assert.TestingT, actual string)
+	}{
+		{
+			name:    "First letter of surname",
+			contact: model.Contact{Surname: "ершов"},
+			key:     model.SortKeySurname,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "Е", actual)
+			},
+		},
+		{
+			name:    "Ё is grouped with Е",
+			contact: model.Contact{Surname: "Ёлкин"},
+			key:     model.SortKeySurname,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "Е", actual)
+			},
+		},
+		{
+			name:    "Not a letter",
+			contact: model.Contact{Name: "1С"},
+			key:     model.SortKeyName,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, OtherGroup, actual)
+			},
+		},
+		{
+			name:    "Birthday month",
+			contact: model.Contact{Birthday: time.Date(1990, time.March, 14, 0, 0, 0, 0, time.UTC)},
+			key:     model.SortKeyBirthday,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "3", actual)
+			},
+		},
+		{
+			name:    "No birthday",
+			contact: model.Contact{},
+			key:     model.SortKeyBirthday,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, NoGroup, actual)
+			},
+		},
+		{
+			name:    "Month and year added",
+			contact: model.Contact{CreatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)},
+			key:     model.SortKeyCreated,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "2026-10", actual)
+			},
+		},
+		{
+			name:    "Unknown update date",
+			contact: model.Contact{CreatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)},
+			key:     model.SortKeyUpdated,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, NoGroup, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewSorter(language.Russian)
+
+			actual := instance.Group(tc.contact, tc.key)
+
+			tc.expectations(t, actual)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package phone
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// Prefix – код страны, с которого начинается маска
+	Prefix = "+7"
+
+	// NationalLength – сколько цифр в номере без кода страны
+	NationalLength = 10
+)
+
+// Digits – номер без кода страны из ввода в любом распространенном виде.
+//
+// Понимает +7 (915) 159-67-81, 8 915 159 67 81, 79151596781 и 9151596781.
+// Лишние цифры отбрасываются.
+func Digits(input string) string {
+	input = strings.TrimSpace(input)
+
+	// Код страны из маски не считаем частью номера
+	withPrefix := strings.HasPrefix(input, Prefix)
+	if withPrefix {
+		input = input[len(Prefix):]
+	}
+
+	digits := make([]rune, 0, NationalLength+1)
+	for _, r := range input {
+		if unicode.IsDigit(r) {
+			digits = append(digits, r)
+		}
+	}
+
+	// 8 или 7 перед полным номером – код страны
+	if !withPrefix && len(digits) == NationalLength+1 && (digits[0] == '7' || digits[0] == '8') {
+		digits = digits[1:]
+	}
+
+	if len(digits) > NationalLength {
+		digits = digits[:NationalLength]
+	}
+
+	return string(digits)
+}
+
+// Format – маска +7 (915) 159-67-81 для цифр номера без кода страны.
+//
+// Неполный номер форматируется до последней введенной цифры: "915" → "+7 (915".
+func Format(digits string) string {
+	if digits == "" {
+		return ""
+	}
+
+	if len(digits) > NationalLength {
+		digits = digits[:NationalLength]
+	}
+
+	var b strings.Builder
+	b.WriteString(Prefix + " (")
+
+	for i, r := range digits {
+		switch i {
+		case 3:
+			b.WriteString(") ")
+		case 6, 8:
+			b.WriteString("-")
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// Position – позиция в отформатированном номере сразу после count цифр.
+//
+// Нужна, чтобы курсор оставался рядом с той же цифрой после переформатирования.
+func Position(formatted string, count int) int {
+	runes := []rune(formatted)
+
+	start := 0
+	if strings.HasPrefix(formatted, Prefix) {
+		start = len([]rune(Prefix))
+	}
+
+	if count <= 0 {
+		// Перед первой цифрой, после "+7 ("
+		for i := start; i < len(runes); i++ {
+			if unicode.IsDigit(runes[i]) {
+				return i
+			}
+		}
+
+		return len(runes)
+	}
+
+	seen := 0
+	for i := start; i < len(runes); i++ {
+		if !unicode.IsDigit(runes[i]) {
+			continue
+		}
+
+		seen++
+		if seen == count {
+			return i + 1
+		}
+	}
+
+	return len(runes)
+}
+
+// Count – сколько цифр номера стоит в formatted до позиции position
+func Count(formatted string, position int) int {
+	runes := []rune(formatted)
+	if position > len(runes) {
+		position = len(runes)
+	}
+
+	start := 0
+	if strings.HasPrefix(formatted, Prefix) {
+		start = len([]rune(Prefix))
+	}
+
+	count := 0
+	for i := start; i < position; i++ {
+		if unicode.IsDigit(runes[i]) {
+			count++
+		}
+	}
+
+	return count
+}
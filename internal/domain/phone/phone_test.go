@@ -0,0 +1,176 @@
+package phone_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "contacts/internal/domain/phone"
+)
+
+func TestDigits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		expectations func(t assert.TestingT, actual string)
+	}{
+		{
+			name:  "Mask",
+			input: "+7 (915) 159-67-81",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "9151596781", actual)
+			},
+		},
+		{
+			name:  "Leading eight with spaces",
+			input: "8 915 159 67 81",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "9151596781", actual)
+			},
+		},
+		{
+			name:  "Leading seven without plus",
+			input: "79151596781",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "9151596781", actual)
+			},
+		},
+		{
+			name:  "National number",
+			input: "(915)159-6781",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "9151596781", actual)
+			},
+		},
+		{
+			name:  "Partial mask",
+			input: "+7 (91",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "91", actual)
+			},
+		},
+		{
+			name:  "Too many digits",
+			input: "+7 (915) 159-67-8123",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "9151596781", actual)
+			},
+		},
+		{
+			name:  "Empty",
+			input: "",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "", actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.expectations(t, Digits(tc.input))
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		digits       string
+		expectations func(t assert.TestingT, actual string)
+	}{
+		{
+			name:   "Full number",
+			digits: "9151596781",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "+7 (915) 159-67-81", actual)
+			},
+		},
+		{
+			name:   "Operator code only",
+			digits: "915",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "+7 (915", actual)
+			},
+		},
+		{
+			name:   "Partial number",
+			digits: "9151596",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "+7 (915) 159-6", actual)
+			},
+		},
+		{
+			name:   "Empty",
+			digits: "",
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, "", actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.expectations(t, Format(tc.digits))
+		})
+	}
+}
+
+func TestPositionAndCount(t *testing.T) {
+	t.Parallel()
+
+	formatted := "+7 (915) 159-67-81"
+
+	tests := []struct {
+		name         string
+		count        int
+		expectations func(t assert.TestingT, position int)
+	}{
+		{
+			name:  "Before first digit",
+			count: 0,
+			expectations: func(t assert.TestingT, position int) {
+				assert.Equal(t, 4, position)
+			},
+		},
+		{
+			name:  "After operator code",
+			count: 3,
+			expectations: func(t assert.TestingT, position int) {
+				assert.Equal(t, 7, position)
+			},
+		},
+		{
+			name:  "After last digit",
+			count: 10,
+			expectations: func(t assert.TestingT, position int) {
+				assert.Equal(t, len(formatted), position)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			position := Position(formatted, tc.count)
+			tc.expectations(t, position)
+
+			// Обратное преобразование дает то же количество цифр
+			assert.Equal(t, tc.count, Count(formatted, position))
+		})
+	}
+}
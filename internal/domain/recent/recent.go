@@ -0,0 +1,37 @@
+package recent
+
+// DefaultLimit – сколько недавно открытых контактов помнить
+const DefaultLimit = 10
+
+type Tracker struct {
+	limit int
+}
+
+func NewTracker(limit int) *Tracker {
+	return &Tracker{
+		limit: limit,
+	}
+}
+
+// Push – ставит uuid в начало списка недавних.
+//
+// Повторное открытие переносит контакт в начало, самые старые записи сверх лимита отбрасываются.
+// Исходный срез не меняется.
+func (t *Tracker) Push(uuids []string, uuid string) []string {
+	pushed := make([]string, 0, min(len(uuids)+1, t.limit))
+	pushed = append(pushed, uuid)
+
+	for _, existing := range uuids {
+		if len(pushed) >= t.limit {
+			break
+		}
+
+		if existing == uuid {
+			continue
+		}
+
+		pushed = append(pushed, existing)
+	}
+
+	return pushed
+}
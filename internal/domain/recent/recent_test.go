@@ -0,0 +1,72 @@
+package recent_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "contacts/internal/domain/recent"
+)
+
+func TestTracker_Push(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		limit        int
+		uuids        []string
+		uuid         string
+		expectations func(t assert.TestingT, actual []string)
+	}{
+		{
+			name:  "Empty list",
+			limit: 3,
+			uuids: nil,
+			uuid:  "1",
+			expectations: func(t assert.TestingT, actual []string) {
+				assert.Equal(t, []string{"1"}, actual)
+			},
+		},
+		{
+			name:  "New contact goes first",
+			limit: 3,
+			uuids: []string{"1", "2"},
+			uuid:  "3",
+			expectations: func(t assert.TestingT, actual []string) {
+				assert.Equal(t, []string{"3", "1", "2"}, actual)
+			},
+		},
+		{
+			name:  "Reopened contact moves to the front",
+			limit: 3,
+			uuids: []string{"1", "2", "3"},
+			uuid:  "3",
+			expectations: func(t assert.TestingT, actual []string) {
+				assert.Equal(t, []string{"3", "1", "2"}, actual)
+			},
+		},
+		{
+			name:  "Oldest contacts are dropped",
+			limit: 3,
+			uuids: []string{"1", "2", "3"},
+			uuid:  "4",
+			expectations: func(t assert.TestingT, actual []string) {
+				assert.Equal(t, []string{"4", "1", "2"}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewTracker(tc.limit)
+
+			actual := instance.Push(tc.uuids, tc.uuid)
+
+			tc.expectations(t, actual)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package reminder
+
+import (
+	"fmt"
+	"time"
+
+	"contacts/internal/model"
+)
+
+// Planner – решает, о каких днях рождения и о ком из давно забытых пора напомнить
+type Planner struct {
+	morningHour int // С какого часа утра отправлять напоминания
+	daysBefore  int // За сколько дней до дня рождения напомнить заранее
+}
+
+func NewPlanner(morningHour, daysBefore int) *Planner {
+	return &Planner{
+		morningHour: morningHour,
+		daysBefore:  daysBefore,
+	}
+}
+
+// DaysBefore – на сколько дней вперед нужны дни рождения для Due
+func (p *Planner) DaysBefore() int {
+	return p.daysBefore
+}
+
+// Due – напоминания, которые пора отправить: в день рождения и за daysBefore дней до него.
+//
+// До morningHour по локальному времени напоминания не отправляются.
+func (p *Planner) Due(now time.Time, birthdays []model.UpcomingBirthday) []model.Reminder {
+	if now.Hour() < p.morningHour {
+		return nil
+	}
+
+	reminders := make([]model.Reminder, 0)
+	for _, birthday := range birthdays {
+		if birthday.DaysLeft != 0 && birthday.DaysLeft != p.daysBefore {
+			continue
+		}
+
+		reminders = append(reminders, model.Reminder{
+			Key:      fmt.Sprintf("%s/%s/%d", birthday.Date.Format("2006-01-02"), birthday.Contact.UUID, birthday.DaysLeft),
+			Birthday: birthday,
+		})
+	}
+
+	return reminders
+}
+
+// Reconnects – напоминания связаться с контактами, с которыми пора пообщаться.
+//
+// Напоминание о контакте отправляется один раз, пока с ним снова не пообщаются.
+// До morningHour по локальному времени напоминания не отправляются.
+func (p *Planner) Reconnects(now time.Time, due []model.ReconnectDue) []model.ReconnectReminder {
+	if now.Hour() < p.morningHour {
+		return nil
+	}
+
+	reminders := make([]model.ReconnectReminder, 0, len(due))
+	for _, reconnect := range due {
+		last := "never"
+		if !reconnect.LastContacted.IsZero() {
+			last = reconnect.LastContacted.Format("2006-01-02")
+		}
+
+		reminders = append(reminders, model.ReconnectReminder{
+			Key: fmt.Sprintf("%s/%s", reconnect.Contact.UUID, last),
+			Due: reconnect,
+		})
+	}
+
+	return reminders
+}
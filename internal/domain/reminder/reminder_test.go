@@ -0,0 +1,143 @@
+package reminder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "contacts/internal/domain/reminder"
+	"contacts/internal/model"
+)
+
+func TestPlanner_Due(t *testing.T) {
+	t.Parallel()
+
+	today := model.UpcomingBirthday{
+		Contact:  model.Contact{UUID: "1"},
+		Date:     time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+		DaysLeft: 0,
+	}
+
+	inThreeDays := model.UpcomingBirthday{
+		Contact:  model.Contact{UUID: "2"},
+		Date:     time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+		DaysLeft: 3,
+	}
+
+	inTwoDays := model.UpcomingBirthday{
+		Contact:  model.Contact{UUID: "3"},
+		Date:     time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC),
+		DaysLeft: 2,
+	}
+
+	birthdays := []model.UpcomingBirthday{today, inTwoDays, inThreeDays}
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		expectations func(t assert.TestingT, actual []model.Reminder)
+	}{
+		{
+			name: "Too early in the morning",
+			now:  time.Date(2025, 1, 10, 8, 59, 0, 0, time.Local),
+			expectations: func(t assert.TestingT, actual []model.Reminder) {
+				assert.Empty(t, actual)
+			},
+		},
+		{
+			name: "On the birthday and days before",
+			now:  time.Date(2025, 1, 10, 9, 0, 0, 0, time.Local),
+			expectations: func(t assert.TestingT, actual []model.Reminder) {
+				expected := []model.Reminder{
+					{
+						Key:      "2025-01-10/1/0",
+						Birthday: today,
+					},
+					{
+						Key:      "2025-01-13/2/3",
+						Birthday: inThreeDays,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewPlanner(9, 3)
+
+			out := instance.Due(tc.now, birthdays)
+
+			tc.expectations(t, out)
+		})
+	}
+}
+
+func TestPlanner_Reconnects(t *testing.T) {
+	t.Parallel()
+
+	never := model.ReconnectDue{
+		Contact: model.Contact{UUID: "1", KeepInTouch: 30},
+	}
+
+	overdue := model.ReconnectDue{
+		Contact:       model.Contact{UUID: "2", KeepInTouch: 7},
+		LastContacted: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+		DaysOverdue:   10,
+	}
+
+	due := []model.ReconnectDue{never, overdue}
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		expectations func(t assert.TestingT, actual []model.ReconnectReminder)
+	}{
+		{
+			name: "Too early in the morning",
+			now:  time.Date(2026, 10, 19, 8, 59, 0, 0, time.Local),
+			expectations: func(t assert.TestingT, actual []model.ReconnectReminder) {
+				assert.Empty(t, actual)
+			},
+		},
+		{
+			name: "Keyed by last contact",
+			now:  time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local),
+			expectations: func(t assert.TestingT, actual []model.ReconnectReminder) {
+				expected := []model.ReconnectReminder{
+					{
+						Key: "1/never",
+						Due: never,
+					},
+					{
+						Key: "2/2026-10-02",
+						Due: overdue,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewPlanner(9, 3)
+
+			out := instance.Reconnects(tc.now, due)
+
+			tc.expectations(t, out)
+		})
+	}
+}
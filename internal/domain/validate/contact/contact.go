@@ -2,54 +2,52 @@ package contact
 
 import (
 	"errors"
-	"fmt"
 	"regexp"
+	"slices"
 	"time"
 
+	"contacts/internal/domain/contacts"
+	"contacts/internal/domain/date"
 	"contacts/internal/model"
 )
 
 var errValidation = errors.New("validation error")
 
-type Validator struct{}
+type Validator struct {
+	dates dates
+}
 
-func New() *Validator {
-	return &Validator{}
+func New(dates dates) *Validator {
+	return &Validator{
+		dates: dates,
+	}
 }
 
 // Validate – валидирует поля модели Contact.
 //
 // Возвращает мапу полей с соответствующей ошибкой.
-func (v *Validator) Validate(contact model.ContactForCreate) map[model.Field]string {
-	fieldMsgs := make(map[model.Field]string)
-
-	msg, err := v.name(contact.Name)
-	if errors.Is(err, errValidation) {
-		fieldMsgs[model.FieldName] = msg
+// Ошибка – это ключ сообщения и параметры, текст на нужном языке собирает UI.
+func (v *Validator) Validate(contact model.ContactForCreate) map[model.Field]model.Message {
+	fieldMsgs := make(map[model.Field]model.Message)
+
+	values := map[model.Field]string{
+		model.FieldName:     contact.Name,
+		model.FieldSurname:  contact.Surname,
+		model.FieldBirthday: contact.Birthday,
+		model.FieldPhone:    contact.Phone,
+		model.FieldEmail:    contact.Email,
 	}
 
-	msg, err = v.surname(contact.Surname)
-	if errors.Is(err, errValidation) {
-		fieldMsgs[model.FieldSurname] = msg
-	}
-
-	msg, err = v.birthday(contact.Birthday)
-	if errors.Is(err, errValidation) {
-		fieldMsgs[model.FieldBirthday] = msg
-	}
-
-	msg, err = v.phone(contact.Phone)
-	if errors.Is(err, errValidation) {
-		fieldMsgs[model.FieldPhone] = msg
-	}
-
-	msg, err = v.email(contact.Email)
-	if errors.Is(err, errValidation) {
-		fieldMsgs[model.FieldEmail] = msg
+	for field, value := range values {
+		msg, ok := v.ValidateField(field, value)
+		if !ok {
+			fieldMsgs[field] = msg
+		}
 	}
 
+	// Ссылки проверяем все, даже не из списка разрешенных
 	for link, value := range contact.Links {
-		msg, err = v.link(link, value)
+		msg, err := v.link(link, value)
 		if errors.Is(err, errValidation) {
 			fieldMsgs[model.Field(link)] = msg
 		}
@@ -58,74 +56,128 @@ func (v *Validator) Validate(contact model.ContactForCreate) map[model.Field]str
 	return fieldMsgs
 }
 
-func (v *Validator) link(link model.ContactLink, value string) (string, error) {
+// ValidateField – проверка одного поля, например при вводе в форму.
+//
+// ok == false – значение некорректно, msg – сообщение об ошибке.
+// Поля без правил, например фото, всегда корректны.
+func (v *Validator) ValidateField(field model.Field, value string) (msg model.Message, ok bool) {
+	var err error
+
+	switch field {
+	case model.FieldName:
+		msg, err = v.name(value)
+	case model.FieldSurname:
+		msg, err = v.surname(value)
+	case model.FieldBirthday:
+		msg, err = v.birthday(value)
+	case model.FieldPhone:
+		msg, err = v.phone(value)
+	case model.FieldEmail:
+		msg, err = v.email(value)
+	default:
+		link := model.ContactLink(field)
+		if !slices.Contains(contacts.AllowedLinks(), link) {
+			return model.Message{}, true
+		}
+
+		msg, err = v.link(link, value)
+	}
+
+	if errors.Is(err, errValidation) {
+		return msg, false
+	}
+
+	return model.Message{}, true
+}
+
+func (v *Validator) link(link model.ContactLink, value string) (model.Message, error) {
 	re := regexp.MustCompile(`^(https?://[a-zA-Z0-9.-]+(?:/[^\s]*)?)$`)
 
 	ok := re.MatchString(value)
 	if !ok {
-		return fmt.Sprintf("Ссылка %s некорректная.\nФормат: https://ya.ru", string(link)), errValidation
+		return model.NewMessage(model.MsgValidationLink, map[string]any{
+			"Link":    string(link),
+			"Example": "https://ya.ru",
+		}), errValidation
 	}
 
-	return "", nil
+	return model.Message{}, nil
 }
 
-func (v *Validator) email(email string) (string, error) {
+func (v *Validator) email(email string) (model.Message, error) {
 	re := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 
 	ok := re.MatchString(email)
 	if !ok {
-		return "Некорректный email", errValidation
+		return model.NewMessage(model.MsgValidationEmail, nil), errValidation
 	}
 
-	return "", nil
+	return model.Message{}, nil
 }
 
-func (v *Validator) phone(phone string) (string, error) {
+func (v *Validator) phone(phone string) (model.Message, error) {
 	_, err := model.NewPhone(phone)
 	if err != nil {
-		return "Телефон должен иметь формат +7 (915) 159-67-81", errValidation
+		return model.NewMessage(model.MsgValidationPhone, map[string]any{
+			"Example": "+7 (915) 159-67-81",
+		}), errValidation
 	}
 
-	return "", nil
+	return model.Message{}, nil
 }
 
-func (v *Validator) name(name string) (string, error) {
+func (v *Validator) name(name string) (model.Message, error) {
 	re := regexp.MustCompile(`^[А-Яа-яЁё]{2,10}$`)
 
 	ok := re.MatchString(name)
 	if !ok {
-		return "Имя должно состоять только из русских букв\nи иметь длину от 2 до 10 символов", errValidation
+		return model.NewMessage(model.MsgValidationName, map[string]any{
+			"Min": 2,
+			"Max": 10,
+		}), errValidation
 	}
 
-	return "", nil
+	return model.Message{}, nil
 }
 
-func (v *Validator) surname(surname string) (string, error) {
+func (v *Validator) surname(surname string) (model.Message, error) {
 	re := regexp.MustCompile(`^[А-Яа-яЁё]{2,10}$`)
 
 	ok := re.MatchString(surname)
 	if !ok {
-		return "Фамилия должна состоять только из русских букв\nи иметь длину от 2 до 10 символов", errValidation
+		return model.NewMessage(model.MsgValidationSurname, map[string]any{
+			"Min": 2,
+			"Max": 10,
+		}), errValidation
 	}
 
-	return "", nil
+	return model.Message{}, nil
 }
 
-func (v *Validator) birthday(birthday string) (string, error) {
-	t, err := time.Parse("02.01.2006", birthday)
+func (v *Validator) birthday(birthday string) (model.Message, error) {
+	t, err := v.dates.Parse(birthday)
 	if err != nil {
-		return "Дата рождения должна быть в формате 10.01.2001", errValidation
+		return model.NewMessage(model.MsgValidationBirthdayFormat, map[string]any{
+			"Example": v.dates.Example(),
+		}), errValidation
+	}
+
+	// Для дня рождения без года проверять нечего
+	if !date.HasYear(t) {
+		return model.Message{}, nil
 	}
 
 	if t.After(time.Now()) {
-		return "Дата рождения не может быть в будущем", errValidation
+		return model.NewMessage(model.MsgValidationBirthdayFuture, nil), errValidation
 	}
 
 	// Минимальная дата рождения
 	minBirthday := time.Date(1925, 1, 1, 0, 0, 0, 0, time.UTC)
 	if t.Before(minBirthday) {
-		return fmt.Sprintf("Минимальная дата рождения %s", minBirthday.Format("02.01.2006")), errValidation
+		return model.NewMessage(model.MsgValidationBirthdayMin, map[string]any{
+			"Date": v.dates.Format(minBirthday),
+		}), errValidation
 	}
 
-	return "", nil
+	return model.Message{}, nil
 }
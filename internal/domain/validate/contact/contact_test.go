@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"contacts/internal/domain/date"
 	. "contacts/internal/domain/validate/contact"
 	"contacts/internal/model"
 	"contacts/util/pointer"
@@ -29,7 +30,7 @@ func TestValidator_Validate(t *testing.T) {
 	tests := []struct {
 		name         string
 		contact      func() model.ContactForCreate
-		expectations func(t assert.TestingT, actual map[model.Field]string)
+		expectations func(t assert.TestingT, actual map[model.Field]model.Message)
 	}{
 		{
 			name: "Invalid name",
@@ -39,9 +40,9 @@ func TestValidator_Validate(t *testing.T) {
 				copied.Name = "Hello world from vaershov"
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.FieldName: "Имя должно состоять только из русских букв\nи иметь длину от 2 до 10 символов",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.FieldName: model.NewMessage(model.MsgValidationName, map[string]any{"Min": 2, "Max": 10}),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -55,25 +56,25 @@ func TestValidator_Validate(t *testing.T) {
 				copied.Surname = "Hello world from vaershov"
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.FieldSurname: "Фамилия должна состоять только из русских букв\nи иметь длину от 2 до 10 символов",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.FieldSurname: model.NewMessage(model.MsgValidationSurname, map[string]any{"Min": 2, "Max": 10}),
 				}
 
 				assert.Equal(t, expected, actual)
 			},
 		},
 		{
-			name: "Invalid birthday, failed to parse as 02.01.2006",
+			name: "Invalid birthday, failed to parse",
 			contact: func() model.ContactForCreate {
 				// Перезапишем только нужное поле
 				copied := valid
-				copied.Birthday = "2001-01-01"
+				copied.Birthday = "2001/01/01"
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.FieldBirthday: "Дата рождения должна быть в формате 10.01.2001",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.FieldBirthday: model.NewMessage(model.MsgValidationBirthdayFormat, map[string]any{"Example": "10.01.2001"}),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -90,9 +91,9 @@ func TestValidator_Validate(t *testing.T) {
 				copied.Birthday = now.Format("02.01.2006")
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.FieldBirthday: "Дата рождения не может быть в будущем",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.FieldBirthday: model.NewMessage(model.MsgValidationBirthdayFuture, nil),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -106,14 +107,26 @@ func TestValidator_Validate(t *testing.T) {
 				copied.Birthday = "10.01.1910"
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.FieldBirthday: "Минимальная дата рождения 01.01.1925",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.FieldBirthday: model.NewMessage(model.MsgValidationBirthdayMin, map[string]any{"Date": "01.01.1925"}),
 				}
 
 				assert.Equal(t, expected, actual)
 			},
 		},
+		{
+			name: "Birthday without year",
+			contact: func() model.ContactForCreate {
+				// Перезапишем только нужное поле
+				copied := valid
+				copied.Birthday = "29.02"
+				return copied
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				assert.Empty(t, actual)
+			},
+		},
 		{
 			name: "Invalid phone",
 			contact: func() model.ContactForCreate {
@@ -122,9 +135,9 @@ func TestValidator_Validate(t *testing.T) {
 				copied.Phone = "1234"
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.FieldPhone: "Телефон должен иметь формат +7 (915) 159-67-81",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.FieldPhone: model.NewMessage(model.MsgValidationPhone, map[string]any{"Example": "+7 (915) 159-67-81"}),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -138,9 +151,9 @@ func TestValidator_Validate(t *testing.T) {
 				copied.Email = "1234"
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.FieldEmail: "Некорректный email",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.FieldEmail: model.NewMessage(model.MsgValidationEmail, nil),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -156,9 +169,9 @@ func TestValidator_Validate(t *testing.T) {
 				}
 				return copied
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
-				expected := map[model.Field]string{
-					model.Field(model.ContactLinkVk): "Ссылка vk.com некорректная.\nФормат: https://ya.ru",
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
+				expected := map[model.Field]model.Message{
+					model.Field(model.ContactLinkVk): model.NewMessage(model.MsgValidationLink, map[string]any{"Link": "vk.com", "Example": "https://ya.ru"}),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -169,7 +182,7 @@ func TestValidator_Validate(t *testing.T) {
 			contact: func() model.ContactForCreate {
 				return valid
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message) {
 				assert.Empty(t, actual)
 			},
 		},
@@ -181,7 +194,7 @@ func TestValidator_Validate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			instance := New()
+			instance := New(date.NewFormatter("ru"))
 
 			out := instance.Validate(tc.contact())
 
@@ -189,3 +202,91 @@ func TestValidator_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_ValidateField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		field        model.Field
+		value        string
+		expectations func(t assert.TestingT, msg model.Message, ok bool)
+	}{
+		{
+			name:  "Valid name",
+			field: model.FieldName,
+			value: "Виталий",
+			expectations: func(t assert.TestingT, msg model.Message, ok bool) {
+				assert.True(t, ok)
+				assert.Equal(t, model.Message{}, msg)
+			},
+		},
+		{
+			name:  "Invalid surname",
+			field: model.FieldSurname,
+			value: "Ershov",
+			expectations: func(t assert.TestingT, msg model.Message, ok bool) {
+				assert.False(t, ok)
+				assert.Equal(t, model.NewMessage(model.MsgValidationSurname, map[string]any{"Min": 2, "Max": 10}), msg)
+			},
+		},
+		{
+			name:  "Invalid birthday format",
+			field: model.FieldBirthday,
+			value: "2001/01/10",
+			expectations: func(t assert.TestingT, msg model.Message, ok bool) {
+				assert.False(t, ok)
+				assert.Equal(t, model.MsgValidationBirthdayFormat, msg.ID)
+			},
+		},
+		{
+			name:  "Invalid phone",
+			field: model.FieldPhone,
+			value: "123",
+			expectations: func(t assert.TestingT, msg model.Message, ok bool) {
+				assert.False(t, ok)
+				assert.Equal(t, model.MsgValidationPhone, msg.ID)
+			},
+		},
+		{
+			name:  "Invalid email",
+			field: model.FieldEmail,
+			value: "vaershov",
+			expectations: func(t assert.TestingT, msg model.Message, ok bool) {
+				assert.False(t, ok)
+				assert.Equal(t, model.NewMessage(model.MsgValidationEmail, nil), msg)
+			},
+		},
+		{
+			name:  "Invalid allowed link",
+			field: model.Field(model.ContactLinkVk),
+			value: "vk",
+			expectations: func(t assert.TestingT, msg model.Message, ok bool) {
+				assert.False(t, ok)
+				assert.Equal(t, model.MsgValidationLink, msg.ID)
+			},
+		},
+		{
+			name:  "Field without rules",
+			field: model.FieldAvatar,
+			value: "",
+			expectations: func(t assert.TestingT, msg model.Message, ok bool) {
+				assert.True(t, ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := New(date.NewFormatter("ru"))
+
+			msg, ok := instance.ValidateField(tc.field, tc.value)
+
+			tc.expectations(t, msg, ok)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package contact
+
+import "time"
+
+type dates interface {
+	Parse(value string) (time.Time, error)
+	Format(t time.Time) string
+	Example() string
+}
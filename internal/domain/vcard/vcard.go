@@ -0,0 +1,159 @@
+package vcard
+
+import (
+	"bytes"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"contacts/internal/domain/date"
+	"contacts/internal/model"
+)
+
+const (
+	// Максимальная длина строки в байтах, длинные строки переносятся (RFC 2425, 5.8.1)
+	maxLineLength = 75
+
+	birthdayLayout = "2006-01-02"
+
+	// В vCard 3.0 дата обязана содержать год. День рождения без года выгружается
+	// с подставным високосным годом и параметром X-APPLE-OMIT-YEAR, как в Apple Contacts.
+	omitYear          = 1604
+	omitYearParameter = "X-APPLE-OMIT-YEAR=1604"
+)
+
+type Encoder struct{}
+
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+// Encode – контакты в формате vCard 3.0, по одной карточке на контакт.
+//
+// photos – миниатюры PNG по хэшу аватара, контакт без миниатюры выгружается без фото.
+// organizations – названия организаций по UUID, отдел выгружается вторым компонентом ORG.
+func (e *Encoder) Encode(contacts []model.Contact, photos map[string][]byte, organizations map[string]string) []byte {
+	var buf bytes.Buffer
+
+	for _, contact := range contacts {
+		writeLine(&buf, "BEGIN:VCARD")
+		writeLine(&buf, "VERSION:3.0")
+		writeLine(&buf, "UID:"+escape(contact.UUID))
+		writeLine(&buf, "N:"+escape(contact.Surname)+";"+escape(contact.Name)+";;;")
+		writeLine(&buf, "FN:"+escape(strings.TrimSpace(contact.Name+" "+contact.Surname)))
+
+		if !contact.Birthday.IsZero() {
+			if date.HasYear(contact.Birthday) {
+				writeLine(&buf, "BDAY:"+contact.Birthday.Format(birthdayLayout))
+			} else {
+				birthday := time.Date(omitYear, contact.Birthday.Month(), contact.Birthday.Day(), 0, 0, 0, 0, time.UTC)
+				writeLine(&buf, "BDAY;"+omitYearParameter+":"+birthday.Format(birthdayLayout))
+			}
+		}
+
+		if contact.Phone.Number() != 0 {
+			writeLine(&buf, "TEL;TYPE=CELL:+"+strconv.FormatInt(contact.Phone.Number(), 10))
+		}
+
+		if contact.Email != "" {
+			writeLine(&buf, "EMAIL;TYPE=INTERNET:"+escape(contact.Email))
+		}
+
+		organization := organizations[contact.OrganizationUUID]
+		if organization != "" || contact.Department != "" {
+			org := escape(organization)
+			if contact.Department != "" {
+				org += ";" + escape(contact.Department)
+			}
+
+			writeLine(&buf, "ORG:"+org)
+		}
+
+		if contact.Title != "" {
+			writeLine(&buf, "TITLE:"+escape(contact.Title))
+		}
+
+		// Ссылки в стабильном порядке, чтобы выгрузка не менялась от запуска к запуску
+		links := make([]string, 0, len(contact.Links))
+		for link := range contact.Links {
+			links = append(links, string(link))
+		}
+		sort.Strings(links)
+
+		for _, link := range links {
+			value := contact.Links[model.ContactLink(link)]
+			if value == "" {
+				continue
+			}
+
+			if !strings.Contains(value, "://") {
+				value = "https://" + value
+			}
+
+			writeLine(&buf, "URL:"+escape(value))
+		}
+
+		if len(contact.Tags) > 0 {
+			tags := make([]string, 0, len(contact.Tags))
+			for _, tag := range contact.Tags {
+				tags = append(tags, escape(tag))
+			}
+
+			writeLine(&buf, "CATEGORIES:"+strings.Join(tags, ","))
+		}
+
+		// Заметки выгружаются как есть, с разметкой Markdown
+		if strings.TrimSpace(contact.Notes) != "" {
+			writeLine(&buf, "NOTE:"+escape(contact.Notes))
+		}
+
+		if photo, ok := photos[contact.Avatar]; ok && contact.Avatar != "" {
+			writeLine(&buf, "PHOTO;ENCODING=b;TYPE=PNG:"+base64.StdEncoding.EncodeToString(photo))
+		}
+
+		writeLine(&buf, "END:VCARD")
+	}
+
+	return buf.Bytes()
+}
+
+// Спецсимволы текстовых значений (RFC 2426, 4)
+var escaper = strings.NewReplacer(
+	`\`, `\\`,
+	`,`, `\,`,
+	`;`, `\;`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escape – экранирует спецсимволы текстового значения
+func escape(value string) string {
+	return escaper.Replace(value)
+}
+
+// writeLine – пишет строку с CRLF, длинные строки переносит с пробелом в начале продолжения.
+//
+// Строка режется по границе символа, чтобы не разорвать UTF-8.
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineLength
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+
+		// Пробел в начале продолжения тоже занимает место
+		limit = maxLineLength - 1
+	}
+
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,175 @@
+package vcard_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"contacts/internal/domain/date"
+	. "contacts/internal/domain/vcard"
+	"contacts/internal/model"
+)
+
+func TestEncoder_Encode(t *testing.T) {
+	t.Parallel()
+
+	contact := model.Contact{
+		UUID:     "1",
+		Surname:  "Ершов",
+		Name:     "Вадим",
+		Birthday: time.Date(1995, time.March, 14, 0, 0, 0, 0, time.UTC),
+		Phone:    model.NewPhoneFromInt64(79151596781),
+		Email:    "vaershov@avito.ru",
+		Links: map[model.ContactLink]string{
+			model.ContactLinkVk: "vk.com/vaershov",
+		},
+		Avatar: "hash",
+		Tags:   []string{"семья", "работа, офис"},
+		Notes:  "Met at GopherCon;\n**payments**",
+
+		OrganizationUUID: "avito",
+		Department:       "Платформа",
+		Title:            "Разработчик",
+	}
+
+	organizations := map[string]string{
+		"avito": "Авито",
+	}
+
+	tests := []struct {
+		name          string
+		contacts      []model.Contact
+		photos        map[string][]byte
+		organizations map[string]string
+		expectations  func(t assert.TestingT, actual string)
+	}{
+		{
+			name:     "No contacts",
+			contacts: nil,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Empty(t, actual)
+			},
+		},
+		{
+			name:     "All fields",
+			contacts: []model.Contact{contact},
+			photos: map[string][]byte{
+				"hash": []byte("png"),
+			},
+			organizations: organizations,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, strings.Join([]string{
+					"BEGIN:VCARD",
+					"VERSION:3.0",
+					"UID:1",
+					"N:Ершов;Вадим;;;",
+					"FN:Вадим Ершов",
+					"BDAY:1995-03-14",
+					"TEL;TYPE=CELL:+79151596781",
+					"EMAIL;TYPE=INTERNET:vaershov@avito.ru",
+					"ORG:Авито;Платформа",
+					"TITLE:Разработчик",
+					"URL:https://vk.com/vaershov",
+					`CATEGORIES:семья,работа\, офис`,
+					`NOTE:Met at GopherCon\;\n**payments**`,
+					"PHOTO;ENCODING=b;TYPE=PNG:cG5n",
+					"END:VCARD",
+					"",
+				}, "\r\n"), actual)
+			},
+		},
+		{
+			name: "Empty fields are skipped",
+			contacts: []model.Contact{
+				{
+					UUID:    "2",
+					Surname: "Никандров",
+					Avatar:  "missing",
+				},
+			},
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Equal(t, strings.Join([]string{
+					"BEGIN:VCARD",
+					"VERSION:3.0",
+					"UID:2",
+					"N:Никандров;;;;",
+					"FN:Никандров",
+					"END:VCARD",
+					"",
+				}, "\r\n"), actual)
+			},
+		},
+		{
+			name: "Birthday without year",
+			contacts: []model.Contact{
+				{
+					UUID:     "6",
+					Surname:  "Зайцев",
+					Birthday: time.Date(date.UnknownYear, time.February, 29, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Contains(t, actual, "VERSION:3.0\r\n")
+				assert.Contains(t, actual, "FN:Зайцев\r\nBDAY;X-APPLE-OMIT-YEAR=1604:1604-02-29\r\nEND:VCARD")
+				assert.NotContains(t, actual, "BDAY:--")
+			},
+		},
+		{
+			name: "Organization without department",
+			contacts: []model.Contact{
+				{
+					UUID:             "4",
+					Surname:          "Агеев",
+					OrganizationUUID: "avito",
+				},
+				{
+					UUID:             "5",
+					Surname:          "Нестеров",
+					OrganizationUUID: "deleted",
+					Department:       "Продажи; опт",
+				},
+			},
+			organizations: organizations,
+			expectations: func(t assert.TestingT, actual string) {
+				assert.Contains(t, actual, "UID:4\r\nN:Агеев;;;;\r\nFN:Агеев\r\nORG:Авито\r\nEND:VCARD")
+				assert.Contains(t, actual, "FN:Нестеров\r\nORG:;Продажи\\; опт\r\nEND:VCARD")
+			},
+		},
+		{
+			name: "Long lines are folded",
+			contacts: []model.Contact{
+				{
+					UUID:   "3",
+					Avatar: "hash",
+				},
+			},
+			photos: map[string][]byte{
+				"hash": make([]byte, 120),
+			},
+			expectations: func(t assert.TestingT, actual string) {
+				for _, line := range strings.Split(actual, "\r\n") {
+					assert.LessOrEqual(t, len(line), 75)
+				}
+
+				unfolded := strings.ReplaceAll(actual, "\r\n ", "")
+				assert.Contains(t, unfolded, "PHOTO;ENCODING=b;TYPE=PNG:"+strings.Repeat("A", 160)+"\r\n")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance := NewEncoder()
+
+			actual := instance.Encode(tc.contacts, tc.photos, tc.organizations)
+
+			tc.expectations(t, string(actual))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package avatar
+
+import (
+	"context"
+	"fmt"
+)
+
+type Handler struct {
+	blobStorage blobStorage
+	thumbnailer thumbnailer
+}
+
+func NewHandler(b blobStorage, t thumbnailer) *Handler {
+	return &Handler{
+		blobStorage: b,
+		thumbnailer: t,
+	}
+}
+
+// Import – делает миниатюру из исходного изображения и сохраняет ее.
+//
+// Возвращает хэш миниатюры, который записывается в контакт.
+func (h *Handler) Import(_ context.Context, data []byte) (string, error) {
+	thumbnail, err := h.thumbnailer.Thumbnail(data)
+	if err != nil {
+		return "", fmt.Errorf("thumbnail: %w", err)
+	}
+
+	hash, err := h.blobStorage.Save(thumbnail)
+	if err != nil {
+		return "", fmt.Errorf("save: %w", err)
+	}
+
+	return hash, nil
+}
+
+// Fetch – получить миниатюру по хэшу
+func (h *Handler) Fetch(_ context.Context, hash string) ([]byte, error) {
+	return h.blobStorage.Read(hash)
+}
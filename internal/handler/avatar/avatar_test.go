@@ -0,0 +1,153 @@
+package avatar_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	. "contacts/internal/handler/avatar"
+)
+
+func TestHandler_Import(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("image")
+	thumbnail := []byte("thumbnail")
+
+	tests := []struct {
+		name         string
+		data         []byte
+		prepare      func(blobStorage *MockblobStorage, thumbnailer *Mockthumbnailer)
+		expectations func(t assert.TestingT, actual string, err error)
+	}{
+		{
+			name: "Failed to make thumbnail",
+			data: data,
+			prepare: func(_ *MockblobStorage, thumbnailer *Mockthumbnailer) {
+				thumbnailer.EXPECT().
+					Thumbnail(data).
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual string, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Failed to save thumbnail",
+			data: data,
+			prepare: func(blobStorage *MockblobStorage, thumbnailer *Mockthumbnailer) {
+				thumbnailer.EXPECT().
+					Thumbnail(data).
+					Return(thumbnail, nil)
+
+				blobStorage.EXPECT().
+					Save(thumbnail).
+					Return("", assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual string, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Success",
+			data: data,
+			prepare: func(blobStorage *MockblobStorage, thumbnailer *Mockthumbnailer) {
+				thumbnailer.EXPECT().
+					Thumbnail(data).
+					Return(thumbnail, nil)
+
+				blobStorage.EXPECT().
+					Save(thumbnail).
+					Return("hash", nil)
+			},
+			expectations: func(t assert.TestingT, actual string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "hash", actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockBlobStorage := NewMockblobStorage(ctrl)
+			mockThumbnailer := NewMockthumbnailer(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockBlobStorage, mockThumbnailer)
+			}
+
+			instance := NewHandler(mockBlobStorage, mockThumbnailer)
+
+			out, err := instance.Import(context.Background(), tc.data)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
+
+func TestHandler_Fetch(t *testing.T) {
+	t.Parallel()
+
+	const hash = "hash"
+
+	tests := []struct {
+		name         string
+		hash         string
+		prepare      func(blobStorage *MockblobStorage)
+		expectations func(t assert.TestingT, actual []byte, err error)
+	}{
+		{
+			name: "Failed to read",
+			hash: hash,
+			prepare: func(blobStorage *MockblobStorage) {
+				blobStorage.EXPECT().
+					Read(hash).
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Success",
+			hash: hash,
+			prepare: func(blobStorage *MockblobStorage) {
+				blobStorage.EXPECT().
+					Read(hash).
+					Return([]byte("thumbnail"), nil)
+			},
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("thumbnail"), actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockBlobStorage := NewMockblobStorage(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockBlobStorage)
+			}
+
+			instance := NewHandler(mockBlobStorage, nil)
+
+			out, err := instance.Fetch(context.Background(), tc.hash)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
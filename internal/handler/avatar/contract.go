@@ -0,0 +1,11 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package avatar
+
+type blobStorage interface {
+	Save(data []byte) (string, error)
+	Read(hash string) ([]byte, error)
+}
+
+type thumbnailer interface {
+	Thumbnail(data []byte) ([]byte, error)
+}
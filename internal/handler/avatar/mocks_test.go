@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source contract.go -destination mocks_test.go -package avatar_test
+//
+
+// Package avatar_test is a generated GoMock package.
+package avatar_test
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockblobStorage is a mock of blobStorage interface.
+type MockblobStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockblobStorageMockRecorder
+}
+
+// MockblobStorageMockRecorder is the mock recorder for MockblobStorage.
+type MockblobStorageMockRecorder struct {
+	mock *MockblobStorage
+}
+
+// NewMockblobStorage creates a new mock instance.
+func NewMockblobStorage(ctrl *gomock.Controller) *MockblobStorage {
+	mock := &MockblobStorage{ctrl: ctrl}
+	mock.recorder = &MockblobStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockblobStorage) EXPECT() *MockblobStorageMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockblobStorage) Read(hash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", hash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockblobStorageMockRecorder) Read(hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockblobStorage)(nil).Read), hash)
+}
+
+// Save mocks base method.
+func (m *MockblobStorage) Save(data []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockblobStorageMockRecorder) Save(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockblobStorage)(nil).Save), data)
+}
+
+// Mockthumbnailer is a mock of thumbnailer interface.
+type Mockthumbnailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockthumbnailerMockRecorder
+}
+
+// MockthumbnailerMockRecorder is the mock recorder for Mockthumbnailer.
+type MockthumbnailerMockRecorder struct {
+	mock *Mockthumbnailer
+}
+
+// NewMockthumbnailer creates a new mock instance.
+func NewMockthumbnailer(ctrl *gomock.Controller) *Mockthumbnailer {
+	mock := &Mockthumbnailer{ctrl: ctrl}
+	mock.recorder = &MockthumbnailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockthumbnailer) EXPECT() *MockthumbnailerMockRecorder {
+	return m.recorder
+}
+
+// Thumbnail mocks base method.
+func (m *Mockthumbnailer) Thumbnail(data []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Thumbnail", data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Thumbnail indicates an expected call of Thumbnail.
+func (mr *MockthumbnailerMockRecorder) Thumbnail(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Thumbnail", reflect.TypeOf((*Mockthumbnailer)(nil).Thumbnail), data)
+}
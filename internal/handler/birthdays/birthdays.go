@@ -0,0 +1,93 @@
+package birthdays
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	dateDomain "contacts/internal/domain/date"
+	"contacts/internal/model"
+)
+
+const day = 24 * time.Hour
+
+type Handler struct {
+	storage storage
+	sorter  sorter
+	clock   clock
+}
+
+func NewHandler(s storage, sorter sorter, c clock) *Handler {
+	return &Handler{
+		storage: s,
+		sorter:  sorter,
+		clock:   c,
+	}
+}
+
+// Upcoming – дни рождения в ближайшие days дней, включая сегодняшний.
+//
+// Сегодняшний день определяется по локальному времени часов.
+// Родившиеся 29 февраля в невисокосный год празднуют 28 февраля.
+// Результат отсортирован по количеству оставшихся дней, затем по фамилии и имени по правилам языка.
+func (h *Handler) Upcoming(_ context.Context, days int) ([]model.UpcomingBirthday, error) {
+	contacts, err := h.storage.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+
+	// Порядок по фамилии сохраняется при сортировке по оставшимся дням
+	h.sorter.Sort(contacts, model.SortKeySurname)
+
+	now := h.clock.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	upcoming := make([]model.UpcomingBirthday, 0)
+	for _, contact := range contacts {
+		date := occurrence(contact.Birthday, today.Year())
+		if date.Before(today) {
+			date = occurrence(contact.Birthday, today.Year()+1)
+		}
+
+		daysLeft := int(date.Sub(today) / day)
+		if daysLeft > days {
+			continue
+		}
+
+		// Для дня рождения без года возраст неизвестен
+		age := 0
+		if dateDomain.HasYear(contact.Birthday) {
+			age = date.Year() - contact.Birthday.Year()
+		}
+
+		upcoming = append(upcoming, model.UpcomingBirthday{
+			Contact:  contact,
+			Date:     date,
+			Age:      age,
+			DaysLeft: daysLeft,
+		})
+	}
+
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].DaysLeft < upcoming[j].DaysLeft
+	})
+
+	return upcoming, nil
+}
+
+// occurrence – дата дня рождения в указанном году
+func occurrence(birthday time.Time, year int) time.Time {
+	month, dayOfMonth := birthday.Month(), birthday.Day()
+
+	// В невисокосный год 29 февраля переносим на 28 февраля
+	if month == time.February && dayOfMonth == 29 && !isLeap(year) {
+		dayOfMonth = 28
+	}
+
+	return time.Date(year, month, dayOfMonth, 0, 0, 0, 0, time.UTC)
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -0,0 +1,255 @@
+package birthdays_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"contacts/internal/domain/date"
+	"contacts/internal/domain/order"
+	. "contacts/internal/handler/birthdays"
+	"contacts/internal/model"
+)
+
+func TestHandler_Upcoming(t *testing.T) {
+	t.Parallel()
+
+	ershov := model.Contact{
+		UUID:     "1",
+		Surname:  "Ершов",
+		Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	zaitsev := model.Contact{
+		UUID:     "2",
+		Surname:  "Зайцев",
+		Birthday: time.Date(1985, 1, 13, 0, 0, 0, 0, time.UTC),
+	}
+
+	leap := model.Contact{
+		UUID:     "3",
+		Surname:  "Високосов",
+		Birthday: time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC),
+	}
+
+	noYear := model.Contact{
+		UUID:     "4",
+		Surname:  "Безгодов",
+		Birthday: time.Date(date.UnknownYear, 1, 11, 0, 0, 0, 0, time.UTC),
+	}
+
+	belov := model.Contact{
+		UUID:     "5",
+		Surname:  "Белов",
+		Birthday: time.Date(1990, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	elkin := model.Contact{
+		UUID:     "6",
+		Surname:  "Ёлкин",
+		Birthday: time.Date(1990, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	// 00:30 10 января по Москве – в UTC это еще 9 января, но день рождения уже сегодня
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	tests := []struct {
+		name         string
+		days         int
+		now          time.Time
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, actual []model.UpcomingBirthday, err error)
+	}{
+		{
+			name: "Failed to fetch",
+			days: 7,
+			now:  time.Date(2025, 1, 9, 23, 30, 0, 0, moscow),
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []model.UpcomingBirthday, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Today and within horizon",
+			days: 3,
+			now:  time.Date(2025, 1, 10, 0, 30, 0, 0, moscow),
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{zaitsev, ershov, leap}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.UpcomingBirthday, err error) {
+				assert.NoError(t, err)
+
+				expected := []model.UpcomingBirthday{
+					{
+						Contact:  ershov,
+						Date:     time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+						Age:      24,
+						DaysLeft: 0,
+					},
+					{
+						Contact:  zaitsev,
+						Date:     time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+						Age:      40,
+						DaysLeft: 3,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name: "Birthday passed this year moves to the next one",
+			days: 365,
+			now:  time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC),
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{ershov}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.UpcomingBirthday, err error) {
+				assert.NoError(t, err)
+
+				expected := []model.UpcomingBirthday{
+					{
+						Contact:  ershov,
+						Date:     time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC),
+						Age:      25,
+						DaysLeft: 10,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name: "Feb 29 in non-leap year is celebrated on Feb 28",
+			days: 0,
+			now:  time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC),
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{leap}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.UpcomingBirthday, err error) {
+				assert.NoError(t, err)
+
+				expected := []model.UpcomingBirthday{
+					{
+						Contact:  leap,
+						Date:     time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+						Age:      25,
+						DaysLeft: 0,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name: "Feb 29 in leap year",
+			days: 1,
+			now:  time.Date(2028, 2, 28, 9, 0, 0, 0, time.UTC),
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{leap}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.UpcomingBirthday, err error) {
+				assert.NoError(t, err)
+
+				expected := []model.UpcomingBirthday{
+					{
+						Contact:  leap,
+						Date:     time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+						Age:      28,
+						DaysLeft: 1,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name: "Birthday without year has unknown age",
+			days: 3,
+			now:  time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC),
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{noYear}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.UpcomingBirthday, err error) {
+				assert.NoError(t, err)
+
+				expected := []model.UpcomingBirthday{
+					{
+						Contact:  noYear,
+						Date:     time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC),
+						Age:      0,
+						DaysLeft: 1,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name: "Same day sorted by surname in Russian alphabet",
+			days: 0,
+			now:  time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC),
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{ershov, elkin, belov}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.UpcomingBirthday, err error) {
+				assert.NoError(t, err)
+
+				surnames := make([]string, 0, len(actual))
+				for _, birthday := range actual {
+					surnames = append(surnames, birthday.Contact.Surname)
+				}
+
+				// Ё идет вместе с Е, а не перед А, как по кодам символов
+				assert.Equal(t, []string{"Белов", "Ёлкин", "Ершов"}, surnames)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(tc.now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, order.NewSorter(language.Russian), mockClock)
+
+			out, err := instance.Upcoming(context.Background(), tc.days)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package birthdays
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+type storage interface {
+	Fetch() ([]model.Contact, error)
+}
+
+type sorter interface {
+	Sort(contacts []model.Contact, key model.SortKey)
+}
+
+type clock interface {
+	Now() time.Time
+}
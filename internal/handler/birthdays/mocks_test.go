@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source contract.go -destination mocks_test.go -package birthdays_test
+//
+
+// Package birthdays_test is a generated GoMock package.
+package birthdays_test
+
+import (
+	model "contacts/internal/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockstorage is a mock of storage interface.
+type Mockstorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockstorageMockRecorder
+}
+
+// MockstorageMockRecorder is the mock recorder for Mockstorage.
+type MockstorageMockRecorder struct {
+	mock *Mockstorage
+}
+
+// NewMockstorage creates a new mock instance.
+func NewMockstorage(ctrl *gomock.Controller) *Mockstorage {
+	mock := &Mockstorage{ctrl: ctrl}
+	mock.recorder = &MockstorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockstorage) EXPECT() *MockstorageMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *Mockstorage) Fetch() ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockstorageMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockstorage)(nil).Fetch))
+}
+
+// Mocksorter is a mock of sorter interface.
+type Mocksorter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksorterMockRecorder
+}
+
+// MocksorterMockRecorder is the mock recorder for Mocksorter.
+type MocksorterMockRecorder struct {
+	mock *Mocksorter
+}
+
+// NewMocksorter creates a new mock instance.
+func NewMocksorter(ctrl *gomock.Controller) *Mocksorter {
+	mock := &Mocksorter{ctrl: ctrl}
+	mock.recorder = &MocksorterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksorter) EXPECT() *MocksorterMockRecorder {
+	return m.recorder
+}
+
+// Sort mocks base method.
+func (m *Mocksorter) Sort(contacts []model.Contact, key model.SortKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Sort", contacts, key)
+}
+
+// Sort indicates an expected call of Sort.
+func (mr *MocksorterMockRecorder) Sort(contacts, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sort", reflect.TypeOf((*Mocksorter)(nil).Sort), contacts, key)
+}
+
+// Mockclock is a mock of clock interface.
+type Mockclock struct {
+	ctrl     *gomock.Controller
+	recorder *MockclockMockRecorder
+}
+
+// MockclockMockRecorder is the mock recorder for Mockclock.
+type MockclockMockRecorder struct {
+	mock *Mockclock
+}
+
+// NewMockclock creates a new mock instance.
+func NewMockclock(ctrl *gomock.Controller) *Mockclock {
+	mock := &Mockclock{ctrl: ctrl}
+	mock.recorder = &MockclockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclock) EXPECT() *MockclockMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *Mockclock) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockclockMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*Mockclock)(nil).Now))
+}
@@ -2,34 +2,40 @@ package create
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	"contacts/internal/model"
 )
 
 type Handler struct {
-	storage   storage
-	uuid      uuid
-	validator validator
+	storage       storage
+	organizations organizations
+	uuid          uuid
+	validator     validator
+	dates         dates
+	clock         clock
 }
 
-func NewHandler(s storage, uuid uuid, v validator) *Handler {
+func NewHandler(s storage, o organizations, uuid uuid, v validator, d dates, c clock) *Handler {
 	return &Handler{
-		storage:   s,
-		uuid:      uuid,
-		validator: v,
+		storage:       s,
+		organizations: o,
+		uuid:          uuid,
+		validator:     v,
+		dates:         d,
+		clock:         c,
 	}
 }
 
-func (h *Handler) Create(_ context.Context, contactForCreate model.ContactForCreate) (map[model.Field]string, error) {
+func (h *Handler) Create(ctx context.Context, contactForCreate model.ContactForCreate) (map[model.Field]model.Message, error) {
 	fieldMsgs := h.validator.Validate(contactForCreate)
 
 	if len(fieldMsgs) > 0 {
 		return fieldMsgs, model.ErrValidation
 	}
 
-	birthday, err := time.Parse("02.01.2006", contactForCreate.Birthday)
+	birthday, err := h.dates.Parse(contactForCreate.Birthday)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +45,14 @@ func (h *Handler) Create(_ context.Context, contactForCreate model.ContactForCre
 		return nil, err
 	}
 
+	// Контакт без источника создан вручную в приложении
+	source := contactForCreate.Source
+	if source == "" {
+		source = model.SourceManual
+	}
+
+	now := h.clock.Now()
+
 	contact := model.Contact{
 		UUID:     h.uuid.NewString(),
 		Surname:  contactForCreate.Surname,
@@ -47,10 +61,36 @@ func (h *Handler) Create(_ context.Context, contactForCreate model.ContactForCre
 		Phone:    phone,
 		Email:    contactForCreate.Email,
 		Links:    contactForCreate.Links,
+		Avatar:   contactForCreate.Avatar,
+		Tags:     contactForCreate.Tags,
+		Favorite: contactForCreate.Favorite,
+		Notes:    contactForCreate.Notes,
+
+		Department: contactForCreate.Department,
+		Title:      contactForCreate.Title,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+		Source:    source,
 	}
 
-	err = h.storage.Create(contact)
+	// Организация ищется или создается только для прошедшего проверку контакта
+	err = h.organizations.SaveWithOrganization(ctx, contactForCreate.Organization, func(organizationUUID string) error {
+		contact.OrganizationUUID = organizationUUID
+		return h.storage.Create(contact)
+	})
 	if err != nil {
+		// Телефон или email уже принадлежат другому контакту
+		var violation *model.UniqueViolationError
+		if errors.As(err, &violation) && !violation.Blocked {
+			// Контакт сохранен, но пользователя нужно предупредить
+			return violation.Fields, model.ErrUniqueWarning
+		}
+
+		if errors.As(err, &violation) {
+			return violation.Fields, model.ErrValidation
+		}
+
 		return nil, fmt.Errorf("create: %w", err)
 	}
 
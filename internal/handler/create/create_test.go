@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"contacts/internal/domain/date"
 	. "contacts/internal/handler/create"
 	"contacts/internal/model"
 )
@@ -15,6 +16,8 @@ import (
 func TestHandler_Create(t *testing.T) {
 	t.Parallel()
 
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
 	contact := model.ContactForCreate{
 		Name:     "Виталий",
 		Surname:  "Ершов",
@@ -24,29 +27,30 @@ func TestHandler_Create(t *testing.T) {
 		Links: map[model.ContactLink]string{
 			model.ContactLinkVk: "vk.com",
 		},
+		Avatar: "hash",
 	}
 
 	tests := []struct {
 		name             string
 		contactForCreate model.ContactForCreate
-		prepare          func(storage *Mockstorage, validator *Mockvalidator, uuid *Mockuuid)
-		expectations     func(t assert.TestingT, actual map[model.Field]string, err error)
+		prepare          func(storage *Mockstorage, organizations *Mockorganizations, validator *Mockvalidator, uuid *Mockuuid)
+		expectations     func(t assert.TestingT, actual map[model.Field]model.Message, err error)
 	}{
 		{
 			name:             "Validation error",
 			contactForCreate: contact,
-			prepare: func(_ *Mockstorage, validator *Mockvalidator, _ *Mockuuid) {
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, _ *Mockuuid) {
 				validator.EXPECT().
 					Validate(contact).
-					Return(map[model.Field]string{
-						model.FieldName: "msg",
+					Return(map[model.Field]model.Message{
+						model.FieldName: model.NewMessage("msg", nil),
 					})
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.ErrorIs(t, err, model.ErrValidation)
 
-				expected := map[model.Field]string{
-					model.FieldName: "msg",
+				expected := map[model.Field]model.Message{
+					model.FieldName: model.NewMessage("msg", nil),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -55,18 +59,18 @@ func TestHandler_Create(t *testing.T) {
 		{
 			name: "Failed to parse birthday from string",
 			contactForCreate: model.ContactForCreate{
-				Birthday: "2001-01-10",
+				Birthday: "2001/01/10",
 				Phone:    "+7 (915) 159-67-81",
 			},
-			prepare: func(_ *Mockstorage, validator *Mockvalidator, _ *Mockuuid) {
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, _ *Mockuuid) {
 				validator.EXPECT().
 					Validate(model.ContactForCreate{
-						Birthday: "2001-01-10",
+						Birthday: "2001/01/10",
 						Phone:    "+7 (915) 159-67-81",
 					}).
 					Return(nil)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.Error(t, err)
 			},
 		},
@@ -76,7 +80,7 @@ func TestHandler_Create(t *testing.T) {
 				Birthday: "10.01.2001",
 				Phone:    "+7 (915) 1596781",
 			},
-			prepare: func(_ *Mockstorage, validator *Mockvalidator, _ *Mockuuid) {
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, _ *Mockuuid) {
 				validator.EXPECT().
 					Validate(model.ContactForCreate{
 						Birthday: "10.01.2001",
@@ -84,14 +88,14 @@ func TestHandler_Create(t *testing.T) {
 					}).
 					Return(nil)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.Error(t, err)
 			},
 		},
 		{
 			name:             "Failed to create in storage",
 			contactForCreate: contact,
-			prepare: func(storage *Mockstorage, validator *Mockvalidator, uuid *Mockuuid) {
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, uuid *Mockuuid) {
 				validator.EXPECT().
 					Validate(contact).
 					Return(nil)
@@ -114,13 +118,230 @@ func TestHandler_Create(t *testing.T) {
 						Links: map[model.ContactLink]string{
 							model.ContactLinkVk: "vk.com",
 						},
+						Avatar:    "hash",
+						CreatedAt: now,
+						UpdatedAt: now,
+						Source:    model.SourceManual,
 					}).
 					Return(assert.AnError)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name:             "Phone already belongs to another contact",
+			contactForCreate: contact,
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, uuid *Mockuuid) {
+				validator.EXPECT().
+					Validate(contact).
+					Return(nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("uuid")
+
+				b, err := time.Parse("02.01.2006", contact.Birthday)
+				assert.NoError(t, err)
+
+				storage.EXPECT().
+					Create(model.Contact{
+						UUID:     "uuid",
+						Name:     "Виталий",
+						Surname:  "Ершов",
+						Birthday: b,
+						Phone:    model.NewPhoneFromInt64(79151596781),
+						Email:    "vaershov@avito.ru",
+						Links: map[model.ContactLink]string{
+							model.ContactLinkVk: "vk.com",
+						},
+						Avatar:    "hash",
+						CreatedAt: now,
+						UpdatedAt: now,
+						Source:    model.SourceManual,
+					}).
+					Return(&model.UniqueViolationError{
+						Fields: map[model.Field]model.Message{
+							model.FieldPhone: model.NewMessage("msg", nil),
+						},
+						Blocked: true,
+					})
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+
+				expected := map[model.Field]model.Message{
+					model.FieldPhone: model.NewMessage("msg", nil),
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name:             "Saved with unique warning",
+			contactForCreate: contact,
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, uuid *Mockuuid) {
+				validator.EXPECT().
+					Validate(contact).
+					Return(nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("uuid")
+
+				b, err := time.Parse("02.01.2006", contact.Birthday)
+				assert.NoError(t, err)
+
+				storage.EXPECT().
+					Create(model.Contact{
+						UUID:     "uuid",
+						Name:     "Виталий",
+						Surname:  "Ершов",
+						Birthday: b,
+						Phone:    model.NewPhoneFromInt64(79151596781),
+						Email:    "vaershov@avito.ru",
+						Links: map[model.ContactLink]string{
+							model.ContactLinkVk: "vk.com",
+						},
+						Avatar:    "hash",
+						CreatedAt: now,
+						UpdatedAt: now,
+						Source:    model.SourceManual,
+					}).
+					Return(&model.UniqueViolationError{
+						Fields: map[model.Field]model.Message{
+							model.FieldEmail: model.NewMessage("msg", nil),
+						},
+					})
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, model.ErrUniqueWarning)
+
+				expected := map[model.Field]model.Message{
+					model.FieldEmail: model.NewMessage("msg", nil),
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name: "Imported contact keeps its source",
+			contactForCreate: model.ContactForCreate{
+				Name:     "Виталий",
+				Birthday: "10.01.2001",
+				Phone:    "+7 (915) 159-67-81",
+				Source:   model.SourceImportVCard,
+			},
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, uuid *Mockuuid) {
+				validator.EXPECT().
+					Validate(gomock.Any()).
+					Return(nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("uuid")
+
+				storage.EXPECT().
+					Create(model.Contact{
+						UUID:      "uuid",
+						Name:      "Виталий",
+						Birthday:  time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC),
+						Phone:     model.NewPhoneFromInt64(79151596781),
+						CreatedAt: now,
+						UpdatedAt: now,
+						Source:    model.SourceImportVCard,
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.NoError(t, err)
+				assert.Nil(t, actual)
+			},
+		},
+		{
+			name: "Rejected contact does not create organization",
+			contactForCreate: model.ContactForCreate{
+				Name:         "Виталий",
+				Birthday:     "10.01.2001",
+				Phone:        "+7 (915) 159-67-81",
+				Organization: "Авито",
+			},
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator, _ *Mockuuid) {
+				validator.EXPECT().
+					Validate(gomock.Any()).
+					Return(map[model.Field]model.Message{
+						model.FieldPhone: model.NewMessage("msg", nil),
+					})
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name: "Contact is saved with organization",
+			contactForCreate: model.ContactForCreate{
+				Name:         "Виталий",
+				Birthday:     "10.01.2001",
+				Phone:        "+7 (915) 159-67-81",
+				Organization: "Авито",
+			},
+			prepare: func(storage *Mockstorage, organizations *Mockorganizations, validator *Mockvalidator, uuid *Mockuuid) {
+				validator.EXPECT().
+					Validate(gomock.Any()).
+					Return(nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("uuid")
+
+				organizations.EXPECT().
+					SaveWithOrganization(gomock.Any(), "Авито", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, save func(string) error) error {
+						return save("avito")
+					})
+
+				storage.EXPECT().
+					Create(model.Contact{
+						UUID:             "uuid",
+						Name:             "Виталий",
+						Birthday:         time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC),
+						Phone:            model.NewPhoneFromInt64(79151596781),
+						OrganizationUUID: "avito",
+						CreatedAt:        now,
+						UpdatedAt:        now,
+						Source:           model.SourceManual,
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Failed to save organization",
+			contactForCreate: model.ContactForCreate{
+				Name:         "Виталий",
+				Birthday:     "10.01.2001",
+				Phone:        "+7 (915) 159-67-81",
+				Organization: "Авито",
+			},
+			prepare: func(_ *Mockstorage, organizations *Mockorganizations, validator *Mockvalidator, uuid *Mockuuid) {
+				validator.EXPECT().
+					Validate(gomock.Any()).
+					Return(nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("uuid")
+
+				organizations.EXPECT().
+					SaveWithOrganization(gomock.Any(), "Авито", gomock.Any()).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -131,14 +352,29 @@ func TestHandler_Create(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockStorage := NewMockstorage(ctrl)
+			mockOrganizations := NewMockorganizations(ctrl)
 			mockValidator := NewMockvalidator(ctrl)
 			mockUuid := NewMockuuid(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
 
 			if tc.prepare != nil {
-				tc.prepare(mockStorage, mockValidator, mockUuid)
+				tc.prepare(mockStorage, mockOrganizations, mockValidator, mockUuid)
 			}
 
-			instance := NewHandler(mockStorage, mockUuid, mockValidator)
+			// Контакт без организации сохраняется как есть
+			mockOrganizations.EXPECT().
+				SaveWithOrganization(gomock.Any(), "", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, save func(string) error) error {
+					return save("")
+				}).
+				AnyTimes()
+
+			instance := NewHandler(mockStorage, mockOrganizations, mockUuid, mockValidator, date.NewFormatter("ru"), mockClock)
 
 			out, err := instance.Create(context.Background(), tc.contactForCreate)
 
@@ -11,7 +11,9 @@ package create_test
 
 import (
 	model "contacts/internal/model"
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -53,6 +55,43 @@ func (mr *MockstorageMockRecorder) Create(contact any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*Mockstorage)(nil).Create), contact)
 }
 
+// Mockorganizations is a mock of organizations interface.
+type Mockorganizations struct {
+	ctrl     *gomock.Controller
+	recorder *MockorganizationsMockRecorder
+}
+
+// MockorganizationsMockRecorder is the mock recorder for Mockorganizations.
+type MockorganizationsMockRecorder struct {
+	mock *Mockorganizations
+}
+
+// NewMockorganizations creates a new mock instance.
+func NewMockorganizations(ctrl *gomock.Controller) *Mockorganizations {
+	mock := &Mockorganizations{ctrl: ctrl}
+	mock.recorder = &MockorganizationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockorganizations) EXPECT() *MockorganizationsMockRecorder {
+	return m.recorder
+}
+
+// SaveWithOrganization mocks base method.
+func (m *Mockorganizations) SaveWithOrganization(ctx context.Context, name string, save func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWithOrganization", ctx, name, save)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWithOrganization indicates an expected call of SaveWithOrganization.
+func (mr *MockorganizationsMockRecorder) SaveWithOrganization(ctx, name, save any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWithOrganization", reflect.TypeOf((*Mockorganizations)(nil).SaveWithOrganization), ctx, name, save)
+}
+
 // Mockvalidator is a mock of validator interface.
 type Mockvalidator struct {
 	ctrl     *gomock.Controller
@@ -77,10 +116,10 @@ func (m *Mockvalidator) EXPECT() *MockvalidatorMockRecorder {
 }
 
 // Validate mocks base method.
-func (m *Mockvalidator) Validate(contact model.ContactForCreate) map[model.Field]string {
+func (m *Mockvalidator) Validate(contact model.ContactForCreate) map[model.Field]model.Message {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Validate", contact)
-	ret0, _ := ret[0].(map[model.Field]string)
+	ret0, _ := ret[0].(map[model.Field]model.Message)
 	return ret0
 }
 
@@ -126,3 +165,78 @@ func (mr *MockuuidMockRecorder) NewString() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewString", reflect.TypeOf((*Mockuuid)(nil).NewString))
 }
+
+// Mockdates is a mock of dates interface.
+type Mockdates struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatesMockRecorder
+}
+
+// MockdatesMockRecorder is the mock recorder for Mockdates.
+type MockdatesMockRecorder struct {
+	mock *Mockdates
+}
+
+// NewMockdates creates a new mock instance.
+func NewMockdates(ctrl *gomock.Controller) *Mockdates {
+	mock := &Mockdates{ctrl: ctrl}
+	mock.recorder = &MockdatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdates) EXPECT() *MockdatesMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *Mockdates) Parse(value string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", value)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockdatesMockRecorder) Parse(value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*Mockdates)(nil).Parse), value)
+}
+
+// Mockclock is a mock of clock interface.
+type Mockclock struct {
+	ctrl     *gomock.Controller
+	recorder *MockclockMockRecorder
+}
+
+// MockclockMockRecorder is the mock recorder for Mockclock.
+type MockclockMockRecorder struct {
+	mock *Mockclock
+}
+
+// NewMockclock creates a new mock instance.
+func NewMockclock(ctrl *gomock.Controller) *Mockclock {
+	mock := &Mockclock{ctrl: ctrl}
+	mock.recorder = &MockclockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclock) EXPECT() *MockclockMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *Mockclock) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockclockMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*Mockclock)(nil).Now))
+}
@@ -3,4 +3,5 @@ package delete
 
 type storage interface {
 	Delete(uuid string) error
+	DeleteMany(uuids []string) error
 }
@@ -15,3 +15,12 @@ func NewHandler(s storage) *Handler {
 func (h *Handler) Delete(_ context.Context, uuid string) error {
 	return h.storage.Delete(uuid)
 }
+
+// DeleteMany – удалить выбранные контакты за одно сохранение
+func (h *Handler) DeleteMany(_ context.Context, uuids []string) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	return h.storage.DeleteMany(uuids)
+}
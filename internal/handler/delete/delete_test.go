@@ -68,3 +68,69 @@ func TestHandler_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_DeleteMany(t *testing.T) {
+	t.Parallel()
+
+	uuids := []string{"1", "2"}
+
+	tests := []struct {
+		name         string
+		uuids        []string
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:  "Nothing selected",
+			uuids: nil,
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:  "Failed to delete contacts from storage",
+			uuids: uuids,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					DeleteMany(uuids).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:  "Success",
+			uuids: uuids,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					DeleteMany(uuids).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage)
+
+			err := instance.DeleteMany(context.Background(), tc.uuids)
+
+			tc.expectations(t, err)
+		})
+	}
+}
@@ -51,3 +51,17 @@ func (mr *MockstorageMockRecorder) Delete(uuid any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Mockstorage)(nil).Delete), uuid)
 }
+
+// DeleteMany mocks base method.
+func (m *Mockstorage) DeleteMany(uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockstorageMockRecorder) DeleteMany(uuids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*Mockstorage)(nil).DeleteMany), uuids)
+}
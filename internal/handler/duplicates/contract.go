@@ -0,0 +1,12 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package duplicates
+
+import "contacts/internal/model"
+
+type storage interface {
+	Fetch() ([]model.Contact, error)
+}
+
+type finder interface {
+	Find(contacts []model.Contact) []model.DuplicatePair
+}
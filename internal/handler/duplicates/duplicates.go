@@ -0,0 +1,30 @@
+package duplicates
+
+import (
+	"context"
+	"fmt"
+
+	"contacts/internal/model"
+)
+
+type Handler struct {
+	storage storage
+	finder  finder
+}
+
+func NewHandler(s storage, f finder) *Handler {
+	return &Handler{
+		storage: s,
+		finder:  f,
+	}
+}
+
+// Find – возвращает пары контактов-кандидатов на объединение
+func (h *Handler) Find(_ context.Context) ([]model.DuplicatePair, error) {
+	contacts, err := h.storage.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+
+	return h.finder.Find(contacts), nil
+}
@@ -0,0 +1,92 @@
+package duplicates_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	. "contacts/internal/handler/duplicates"
+	"contacts/internal/model"
+)
+
+func TestHandler_Find(t *testing.T) {
+	t.Parallel()
+
+	contacts := []model.Contact{
+		{
+			UUID:    "1",
+			Surname: "Ершов",
+		},
+		{
+			UUID:    "2",
+			Surname: "Ершов",
+		},
+	}
+
+	pairs := []model.DuplicatePair{
+		{
+			First:   contacts[0],
+			Second:  contacts[1],
+			Score:   0.5,
+			Matches: []model.Field{model.FieldSurname},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		prepare      func(storage *Mockstorage, finder *Mockfinder)
+		expectations func(t assert.TestingT, actual []model.DuplicatePair, err error)
+	}{
+		{
+			name: "Failed to fetch",
+			prepare: func(storage *Mockstorage, _ *Mockfinder) {
+				storage.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []model.DuplicatePair, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Success",
+			prepare: func(storage *Mockstorage, finder *Mockfinder) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				finder.EXPECT().
+					Find(contacts).
+					Return(pairs)
+			},
+			expectations: func(t assert.TestingT, actual []model.DuplicatePair, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, pairs, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockFinder := NewMockfinder(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage, mockFinder)
+			}
+
+			instance := NewHandler(mockStorage, mockFinder)
+
+			out, err := instance.Find(context.Background())
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
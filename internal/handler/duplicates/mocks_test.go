@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source contract.go -destination mocks_test.go -package duplicates_test
+//
+
+// Package duplicates_test is a generated GoMock package.
+package duplicates_test
+
+import (
+	model "contacts/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockstorage is a mock of storage interface.
+type Mockstorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockstorageMockRecorder
+}
+
+// MockstorageMockRecorder is the mock recorder for Mockstorage.
+type MockstorageMockRecorder struct {
+	mock *Mockstorage
+}
+
+// NewMockstorage creates a new mock instance.
+func NewMockstorage(ctrl *gomock.Controller) *Mockstorage {
+	mock := &Mockstorage{ctrl: ctrl}
+	mock.recorder = &MockstorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockstorage) EXPECT() *MockstorageMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *Mockstorage) Fetch() ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockstorageMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockstorage)(nil).Fetch))
+}
+
+// Mockfinder is a mock of finder interface.
+type Mockfinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockfinderMockRecorder
+}
+
+// MockfinderMockRecorder is the mock recorder for Mockfinder.
+type MockfinderMockRecorder struct {
+	mock *Mockfinder
+}
+
+// NewMockfinder creates a new mock instance.
+func NewMockfinder(ctrl *gomock.Controller) *Mockfinder {
+	mock := &Mockfinder{ctrl: ctrl}
+	mock.recorder = &MockfinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockfinder) EXPECT() *MockfinderMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *Mockfinder) Find(contacts []model.Contact) []model.DuplicatePair {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", contacts)
+	ret0, _ := ret[0].([]model.DuplicatePair)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockfinderMockRecorder) Find(contacts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*Mockfinder)(nil).Find), contacts)
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package export
+
+import "contacts/internal/model"
+
+type storage interface {
+	Fetch() ([]model.Contact, error)
+}
+
+type organizations interface {
+	Fetch() ([]model.Organization, error)
+}
+
+type blobStorage interface {
+	Read(hash string) ([]byte, error)
+}
+
+type encoder interface {
+	Encode(contacts []model.Contact, photos map[string][]byte, organizations map[string]string) []byte
+}
@@ -0,0 +1,76 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"contacts/internal/model"
+)
+
+type Handler struct {
+	storage       storage
+	organizations organizations
+	blobStorage   blobStorage
+	encoder       encoder
+}
+
+func NewHandler(s storage, o organizations, b blobStorage, e encoder) *Handler {
+	return &Handler{
+		storage:       s,
+		organizations: o,
+		blobStorage:   b,
+		encoder:       e,
+	}
+}
+
+// Export – выбранные контакты в формате vCard в порядке выбора.
+//
+// Фото, которое не удалось прочитать, не мешает выгрузке: контакт выгружается без него.
+func (h *Handler) Export(_ context.Context, uuids []string) ([]byte, error) {
+	contacts, err := h.storage.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+
+	byUUID := make(map[string]model.Contact, len(contacts))
+	for _, contact := range contacts {
+		byUUID[contact.UUID] = contact
+	}
+
+	selected := make([]model.Contact, 0, len(uuids))
+	photos := make(map[string][]byte)
+	for _, uuid := range uuids {
+		contact, ok := byUUID[uuid]
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", uuid, model.ErrNotFound)
+		}
+
+		selected = append(selected, contact)
+
+		if contact.Avatar == "" {
+			continue
+		}
+
+		if _, ok = photos[contact.Avatar]; ok {
+			continue
+		}
+
+		photo, err := h.blobStorage.Read(contact.Avatar)
+		if err != nil {
+			continue
+		}
+		photos[contact.Avatar] = photo
+	}
+
+	organizations, err := h.organizations.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch organizations: %w", err)
+	}
+
+	names := make(map[string]string, len(organizations))
+	for _, organization := range organizations {
+		names[organization.UUID] = organization.Name
+	}
+
+	return h.encoder.Encode(selected, photos, names), nil
+}
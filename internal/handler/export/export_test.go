@@ -0,0 +1,143 @@
+package export_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	. "contacts/internal/handler/export"
+	"contacts/internal/model"
+)
+
+func TestHandler_Export(t *testing.T) {
+	t.Parallel()
+
+	contacts := []model.Contact{
+		{
+			UUID:   "1",
+			Avatar: "first",
+
+			OrganizationUUID: "avito",
+		},
+		{
+			UUID:   "2",
+			Avatar: "broken",
+		},
+		{
+			UUID: "3",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		uuids        []string
+		prepare      func(storage *Mockstorage, organizations *Mockorganizations, blobStorage *MockblobStorage, encoder *Mockencoder)
+		expectations func(t assert.TestingT, actual []byte, err error)
+	}{
+		{
+			name:  "Failed to fetch contacts",
+			uuids: []string{"1"},
+			prepare: func(storage *Mockstorage, organizations *Mockorganizations, blobStorage *MockblobStorage, encoder *Mockencoder) {
+				storage.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:  "Contact not found",
+			uuids: []string{"4"},
+			prepare: func(storage *Mockstorage, organizations *Mockorganizations, blobStorage *MockblobStorage, encoder *Mockencoder) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+			},
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:  "Failed to fetch organizations",
+			uuids: []string{"3"},
+			prepare: func(storage *Mockstorage, organizations *Mockorganizations, blobStorage *MockblobStorage, encoder *Mockencoder) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				organizations.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			name:  "Success, unreadable photo is skipped",
+			uuids: []string{"3", "2", "1"},
+			prepare: func(storage *Mockstorage, organizations *Mockorganizations, blobStorage *MockblobStorage, encoder *Mockencoder) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				blobStorage.EXPECT().
+					Read("broken").
+					Return(nil, assert.AnError)
+
+				blobStorage.EXPECT().
+					Read("first").
+					Return([]byte("png"), nil)
+
+				organizations.EXPECT().
+					Fetch().
+					Return([]model.Organization{
+						{UUID: "avito", Name: "Авито"},
+					}, nil)
+
+				encoder.EXPECT().
+					Encode(
+						[]model.Contact{contacts[2], contacts[1], contacts[0]},
+						map[string][]byte{
+							"first": []byte("png"),
+						},
+						map[string]string{
+							"avito": "Авито",
+						},
+					).
+					Return([]byte("vcard"))
+			},
+			expectations: func(t assert.TestingT, actual []byte, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("vcard"), actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockOrganizations := NewMockorganizations(ctrl)
+			mockBlobStorage := NewMockblobStorage(ctrl)
+			mockEncoder := NewMockencoder(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage, mockOrganizations, mockBlobStorage, mockEncoder)
+			}
+
+			instance := NewHandler(mockStorage, mockOrganizations, mockBlobStorage, mockEncoder)
+
+			out, err := instance.Export(context.Background(), tc.uuids)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
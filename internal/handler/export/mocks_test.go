@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source contract.go -destination mocks_test.go -package export_test
+//
+
+// Package export_test is a generated GoMock package.
+package export_test
+
+import (
+	model "contacts/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockstorage is a mock of storage interface.
+type Mockstorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockstorageMockRecorder
+}
+
+// MockstorageMockRecorder is the mock recorder for Mockstorage.
+type MockstorageMockRecorder struct {
+	mock *Mockstorage
+}
+
+// NewMockstorage creates a new mock instance.
+func NewMockstorage(ctrl *gomock.Controller) *Mockstorage {
+	mock := &Mockstorage{ctrl: ctrl}
+	mock.recorder = &MockstorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockstorage) EXPECT() *MockstorageMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *Mockstorage) Fetch() ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockstorageMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockstorage)(nil).Fetch))
+}
+
+// Mockorganizations is a mock of organizations interface.
+type Mockorganizations struct {
+	ctrl     *gomock.Controller
+	recorder *MockorganizationsMockRecorder
+}
+
+// MockorganizationsMockRecorder is the mock recorder for Mockorganizations.
+type MockorganizationsMockRecorder struct {
+	mock *Mockorganizations
+}
+
+// NewMockorganizations creates a new mock instance.
+func NewMockorganizations(ctrl *gomock.Controller) *Mockorganizations {
+	mock := &Mockorganizations{ctrl: ctrl}
+	mock.recorder = &MockorganizationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockorganizations) EXPECT() *MockorganizationsMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *Mockorganizations) Fetch() ([]model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].([]model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockorganizationsMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockorganizations)(nil).Fetch))
+}
+
+// MockblobStorage is a mock of blobStorage interface.
+type MockblobStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockblobStorageMockRecorder
+}
+
+// MockblobStorageMockRecorder is the mock recorder for MockblobStorage.
+type MockblobStorageMockRecorder struct {
+	mock *MockblobStorage
+}
+
+// NewMockblobStorage creates a new mock instance.
+func NewMockblobStorage(ctrl *gomock.Controller) *MockblobStorage {
+	mock := &MockblobStorage{ctrl: ctrl}
+	mock.recorder = &MockblobStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockblobStorage) EXPECT() *MockblobStorageMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockblobStorage) Read(hash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", hash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockblobStorageMockRecorder) Read(hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockblobStorage)(nil).Read), hash)
+}
+
+// Mockencoder is a mock of encoder interface.
+type Mockencoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockencoderMockRecorder
+}
+
+// MockencoderMockRecorder is the mock recorder for Mockencoder.
+type MockencoderMockRecorder struct {
+	mock *Mockencoder
+}
+
+// NewMockencoder creates a new mock instance.
+func NewMockencoder(ctrl *gomock.Controller) *Mockencoder {
+	mock := &Mockencoder{ctrl: ctrl}
+	mock.recorder = &MockencoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockencoder) EXPECT() *MockencoderMockRecorder {
+	return m.recorder
+}
+
+// Encode mocks base method.
+func (m *Mockencoder) Encode(contacts []model.Contact, photos map[string][]byte, organizations map[string]string) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encode", contacts, photos, organizations)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// Encode indicates an expected call of Encode.
+func (mr *MockencoderMockRecorder) Encode(contacts, photos, organizations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encode", reflect.TypeOf((*Mockencoder)(nil).Encode), contacts, photos, organizations)
+}
@@ -0,0 +1,17 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package favorite
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+type storage interface {
+	FetchByUuid(uuid string) (model.Contact, error)
+	Update(contact model.Contact) error
+}
+
+type clock interface {
+	Now() time.Time
+}
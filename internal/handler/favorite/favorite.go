@@ -0,0 +1,50 @@
+package favorite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"contacts/internal/model"
+)
+
+type Handler struct {
+	storage storage
+	clock   clock
+}
+
+func NewHandler(s storage, c clock) *Handler {
+	return &Handler{
+		storage: s,
+		clock:   c,
+	}
+}
+
+// SetFavorite – закрепить контакт в начале списка или открепить его.
+//
+// Остальные поля не меняются, поэтому предупреждения об уникальности не считаются ошибкой.
+func (h *Handler) SetFavorite(_ context.Context, uuid string, favorite bool) error {
+	contact, err := h.storage.FetchByUuid(uuid)
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	if contact.Favorite == favorite {
+		return nil
+	}
+
+	contact.Favorite = favorite
+	contact.UpdatedAt = h.clock.Now()
+
+	err = h.storage.Update(contact)
+	if err != nil {
+		var violation *model.UniqueViolationError
+		if errors.As(err, &violation) && !violation.Blocked {
+			return nil
+		}
+
+		return fmt.Errorf("update: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package favorite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	. "contacts/internal/handler/favorite"
+	"contacts/internal/model"
+)
+
+func TestHandler_SetFavorite(t *testing.T) {
+	t.Parallel()
+
+	const uuid = "uuid"
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		favorite     bool
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:     "Failed to fetch contact",
+			favorite: true,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid(uuid).
+					Return(model.Contact{}, model.ErrNotFound)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:     "Already favorite",
+			favorite: true,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid(uuid).
+					Return(model.Contact{UUID: uuid, Favorite: true}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:     "Failed to update contact",
+			favorite: true,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid(uuid).
+					Return(model.Contact{UUID: uuid}, nil)
+
+				storage.EXPECT().
+					Update(model.Contact{UUID: uuid, Favorite: true, UpdatedAt: now}).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:     "Unique warning is not an error",
+			favorite: true,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid(uuid).
+					Return(model.Contact{UUID: uuid}, nil)
+
+				storage.EXPECT().
+					Update(model.Contact{UUID: uuid, Favorite: true, UpdatedAt: now}).
+					Return(&model.UniqueViolationError{})
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:     "Success",
+			favorite: false,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid(uuid).
+					Return(model.Contact{UUID: uuid, Favorite: true}, nil)
+
+				storage.EXPECT().
+					Update(model.Contact{UUID: uuid, UpdatedAt: now}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, mockClock)
+
+			err := instance.SetFavorite(context.Background(), uuid, tc.favorite)
+
+			tc.expectations(t, err)
+		})
+	}
+}
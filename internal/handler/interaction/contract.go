@@ -0,0 +1,31 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package interaction
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+type storage interface {
+	Fetch() ([]model.Contact, error)
+	FetchByUuid(uuid string) (model.Contact, error)
+	SetInteractions(uuid string, interactions []model.Interaction, updatedAt time.Time) error
+	SetKeepInTouch(uuid string, days int, updatedAt time.Time) error
+}
+
+type uuid interface {
+	NewString() string
+}
+
+type dates interface {
+	Parse(value string) (time.Time, error)
+}
+
+type sorter interface {
+	Sort(contacts []model.Contact, key model.SortKey)
+}
+
+type clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,177 @@
+package interaction
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	dateDomain "contacts/internal/domain/date"
+	"contacts/internal/model"
+)
+
+const day = 24 * time.Hour
+
+type Handler struct {
+	storage storage
+	uuid    uuid
+	dates   dates
+	sorter  sorter
+	clock   clock
+}
+
+func NewHandler(s storage, uuid uuid, d dates, sorter sorter, c clock) *Handler {
+	return &Handler{
+		storage: s,
+		uuid:    uuid,
+		dates:   d,
+		sorter:  sorter,
+		clock:   c,
+	}
+}
+
+// Log – записать общение с контактом.
+//
+// Пустая дата – сегодня по локальному времени часов. Дата без года и дата в будущем не принимаются.
+// Журнал хранится от последних записей к первым.
+func (h *Handler) Log(_ context.Context, uuid string, interactionForCreate model.InteractionForCreate) (model.Interaction, error) {
+	if !interactionForCreate.Type.Valid() {
+		return model.Interaction{}, fmt.Errorf("%w: unknown interaction type %q", model.ErrValidation, interactionForCreate.Type)
+	}
+
+	today := h.today()
+
+	date := today
+	if interactionForCreate.Date != "" {
+		parsed, err := h.dates.Parse(interactionForCreate.Date)
+		if err != nil {
+			return model.Interaction{}, fmt.Errorf("%w: %w", model.ErrValidation, err)
+		}
+
+		if !dateDomain.HasYear(parsed) || parsed.After(today) {
+			return model.Interaction{}, fmt.Errorf("%w: date %s", model.ErrValidation, interactionForCreate.Date)
+		}
+
+		date = parsed
+	}
+
+	contact, err := h.storage.FetchByUuid(uuid)
+	if err != nil {
+		return model.Interaction{}, fmt.Errorf("fetch: %w", err)
+	}
+
+	interaction := model.Interaction{
+		UUID: h.uuid.NewString(),
+		Type: interactionForCreate.Type,
+		Date: date,
+		Note: interactionForCreate.Note,
+	}
+
+	interactions := append([]model.Interaction{interaction}, contact.Interactions...)
+	slices.SortStableFunc(interactions, func(a, b model.Interaction) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	err = h.storage.SetInteractions(uuid, interactions, h.clock.Now())
+	if err != nil {
+		return model.Interaction{}, fmt.Errorf("set interactions: %w", err)
+	}
+
+	return interaction, nil
+}
+
+// Remove – удалить запись из журнала общения, запись, которой нет, не считается ошибкой
+func (h *Handler) Remove(_ context.Context, uuid, interactionUUID string) error {
+	contact, err := h.storage.FetchByUuid(uuid)
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	interactions := slices.DeleteFunc(slices.Clone(contact.Interactions), func(interaction model.Interaction) bool {
+		return interaction.UUID == interactionUUID
+	})
+
+	if len(interactions) == len(contact.Interactions) {
+		return nil
+	}
+
+	err = h.storage.SetInteractions(uuid, interactions, h.clock.Now())
+	if err != nil {
+		return fmt.Errorf("set interactions: %w", err)
+	}
+
+	return nil
+}
+
+// SetFrequency – как часто хочется общаться с контактом, в днях, 0 – не напоминать
+func (h *Handler) SetFrequency(_ context.Context, uuid string, days int) error {
+	if days < 0 {
+		return fmt.Errorf("%w: negative frequency %d", model.ErrValidation, days)
+	}
+
+	err := h.storage.SetKeepInTouch(uuid, days, h.clock.Now())
+	if err != nil {
+		return fmt.Errorf("set keep in touch: %w", err)
+	}
+
+	return nil
+}
+
+// Due – контакты, с которыми пора связаться.
+//
+// Контакт попадает в список, если для него задана частота общения и с последнего общения
+// прошло не меньше дней, чем эта частота, или с ним еще ни разу не общались.
+// Сначала идут контакты, с которыми не общались, затем самые просроченные, затем по фамилии и имени по правилам языка.
+func (h *Handler) Due(_ context.Context) ([]model.ReconnectDue, error) {
+	contacts, err := h.storage.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+
+	// Порядок по фамилии сохраняется при сортировке по просрочке
+	h.sorter.Sort(contacts, model.SortKeySurname)
+
+	today := h.today()
+
+	due := make([]model.ReconnectDue, 0)
+	for _, contact := range contacts {
+		if contact.KeepInTouch <= 0 {
+			continue
+		}
+
+		last := contact.LastContacted()
+		if last.IsZero() {
+			due = append(due, model.ReconnectDue{Contact: contact})
+			continue
+		}
+
+		overdue := int(today.Sub(last)/day) - contact.KeepInTouch
+		if overdue < 0 {
+			continue
+		}
+
+		due = append(due, model.ReconnectDue{
+			Contact:       contact,
+			LastContacted: last,
+			DaysOverdue:   overdue,
+		})
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		iNever, jNever := due[i].LastContacted.IsZero(), due[j].LastContacted.IsZero()
+		if iNever != jNever {
+			return iNever
+		}
+		return due[i].DaysOverdue > due[j].DaysOverdue
+	})
+
+	return due, nil
+}
+
+// today – сегодняшний день по локальному времени часов, в UTC без времени, как и даты в журнале
+func (h *Handler) today() time.Time {
+	now := h.clock.Now()
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,470 @@
+package interaction_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"contacts/internal/domain/date"
+	"contacts/internal/domain/order"
+	. "contacts/internal/handler/interaction"
+	"contacts/internal/model"
+)
+
+func TestHandler_Log(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 0, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	earlier := model.Interaction{
+		UUID: "i1",
+		Type: model.InteractionMeeting,
+		Date: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name                 string
+		interactionForCreate model.InteractionForCreate
+		prepare              func(storage *Mockstorage, uuid *Mockuuid)
+		expectations         func(t assert.TestingT, actual model.Interaction, err error)
+	}{
+		{
+			name:                 "Unknown type",
+			interactionForCreate: model.InteractionForCreate{Type: "letter"},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:                 "Invalid date",
+			interactionForCreate: model.InteractionForCreate{Type: model.InteractionCall, Date: "вчера"},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:                 "Date without year",
+			interactionForCreate: model.InteractionForCreate{Type: model.InteractionCall, Date: "10.01"},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:                 "Date in the future",
+			interactionForCreate: model.InteractionForCreate{Type: model.InteractionCall, Date: "20.10.2026"},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:                 "Failed to fetch contact",
+			interactionForCreate: model.InteractionForCreate{Type: model.InteractionCall},
+			prepare: func(storage *Mockstorage, _ *Mockuuid) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{}, model.ErrNotFound)
+			},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:                 "Failed to save",
+			interactionForCreate: model.InteractionForCreate{Type: model.InteractionCall},
+			prepare: func(storage *Mockstorage, uuid *Mockuuid) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1"}, nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("i2")
+
+				storage.EXPECT().
+					SetInteractions("1", gomock.Any(), now).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:                 "Empty date is today in local time",
+			interactionForCreate: model.InteractionForCreate{Type: model.InteractionCall, Note: "Поздравил с повышением"},
+			prepare: func(storage *Mockstorage, uuid *Mockuuid) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1", Interactions: []model.Interaction{earlier}}, nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("i2")
+
+				storage.EXPECT().
+					SetInteractions("1", []model.Interaction{
+						{
+							UUID: "i2",
+							Type: model.InteractionCall,
+							Date: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+							Note: "Поздравил с повышением",
+						},
+						earlier,
+					}, now).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.NoError(t, err)
+
+				expected := model.Interaction{
+					UUID: "i2",
+					Type: model.InteractionCall,
+					Date: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+					Note: "Поздравил с повышением",
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name:                 "Older date is kept in order",
+			interactionForCreate: model.InteractionForCreate{Type: model.InteractionMessage, Date: "15.09.2026"},
+			prepare: func(storage *Mockstorage, uuid *Mockuuid) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1", Interactions: []model.Interaction{earlier}}, nil)
+
+				uuid.EXPECT().
+					NewString().
+					Return("i2")
+
+				storage.EXPECT().
+					SetInteractions("1", []model.Interaction{
+						earlier,
+						{
+							UUID: "i2",
+							Type: model.InteractionMessage,
+							Date: time.Date(2026, time.September, 15, 0, 0, 0, 0, time.UTC),
+						},
+					}, now).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual model.Interaction, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockUuid := NewMockuuid(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage, mockUuid)
+			}
+
+			instance := NewHandler(mockStorage, mockUuid, date.NewFormatter("ru"), order.NewSorter(language.Russian), mockClock)
+
+			out, err := instance.Log(context.Background(), "1", tc.interactionForCreate)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
+
+func TestHandler_Remove(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	call := model.Interaction{UUID: "i1", Type: model.InteractionCall}
+	meeting := model.Interaction{UUID: "i2", Type: model.InteractionMeeting}
+
+	tests := []struct {
+		name            string
+		interactionUUID string
+		prepare         func(storage *Mockstorage)
+		expectations    func(t assert.TestingT, err error)
+	}{
+		{
+			name:            "Failed to fetch contact",
+			interactionUUID: "i1",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{}, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:            "Unknown interaction",
+			interactionUUID: "i3",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1", Interactions: []model.Interaction{call, meeting}}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:            "Success",
+			interactionUUID: "i1",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1", Interactions: []model.Interaction{call, meeting}}, nil)
+
+				storage.EXPECT().
+					SetInteractions("1", []model.Interaction{meeting}, now).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			instance := NewHandler(mockStorage, NewMockuuid(ctrl), date.NewFormatter("ru"), order.NewSorter(language.Russian), mockClock)
+
+			err := instance.Remove(context.Background(), "1", tc.interactionUUID)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestHandler_SetFrequency(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		days         int
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name: "Negative frequency",
+			days: -1,
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name: "Failed to save",
+			days: 30,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					SetKeepInTouch("1", 30, now).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Turn off reminders",
+			days: 0,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					SetKeepInTouch("1", 0, now).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			instance := NewHandler(mockStorage, NewMockuuid(ctrl), date.NewFormatter("ru"), order.NewSorter(language.Russian), mockClock)
+
+			err := instance.SetFrequency(context.Background(), "1", tc.days)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestHandler_Due(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	interactedOn := func(year int, month time.Month, day int) []model.Interaction {
+		return []model.Interaction{
+			{UUID: "i", Type: model.InteractionCall, Date: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)},
+		}
+	}
+
+	never := model.Contact{UUID: "1", Surname: "Ершов", KeepInTouch: 30}
+	overdue := model.Contact{UUID: "2", Surname: "Зайцев", KeepInTouch: 7, Interactions: interactedOn(2026, time.October, 2)}
+	dueToday := model.Contact{UUID: "3", Surname: "Алексеев", KeepInTouch: 14, Interactions: interactedOn(2026, time.October, 5)}
+	notYet := model.Contact{UUID: "4", Surname: "Борисов", KeepInTouch: 30, Interactions: interactedOn(2026, time.October, 1)}
+	noReminders := model.Contact{UUID: "5", Surname: "Васильев"}
+	belov := model.Contact{UUID: "6", Surname: "Белов", KeepInTouch: 30}
+	elkin := model.Contact{UUID: "7", Surname: "Ёлкин", KeepInTouch: 30}
+
+	tests := []struct {
+		name         string
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, actual []model.ReconnectDue, err error)
+	}{
+		{
+			name: "Failed to fetch",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []model.ReconnectDue, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Never contacted first, then most overdue",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{dueToday, notYet, noReminders, overdue, never}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.ReconnectDue, err error) {
+				assert.NoError(t, err)
+
+				expected := []model.ReconnectDue{
+					{
+						Contact: never,
+					},
+					{
+						Contact:       overdue,
+						LastContacted: time.Date(2026, time.October, 2, 0, 0, 0, 0, time.UTC),
+						DaysOverdue:   10,
+					},
+					{
+						Contact:       dueToday,
+						LastContacted: time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC),
+						DaysOverdue:   0,
+					},
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name: "Same overdue sorted by surname in Russian alphabet",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{never, elkin, belov}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.ReconnectDue, err error) {
+				assert.NoError(t, err)
+
+				// Ё идет вместе с Е, а не перед А, как по кодам символов
+				assert.Equal(t, []model.ReconnectDue{
+					{Contact: belov},
+					{Contact: elkin},
+					{Contact: never},
+				}, actual)
+			},
+		},
+		{
+			name: "Nothing is due",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{notYet, noReminders}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.ReconnectDue, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, NewMockuuid(ctrl), date.NewFormatter("ru"), order.NewSorter(language.Russian), mockClock)
+
+			out, err := instance.Due(context.Background())
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
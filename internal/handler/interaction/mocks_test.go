@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source contract.go -destination mocks_test.go -package interaction_test
+//
+
+// Package interaction_test is a generated GoMock package.
+package interaction_test
+
+import (
+	model "contacts/internal/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockstorage is a mock of storage interface.
+type Mockstorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockstorageMockRecorder
+}
+
+// MockstorageMockRecorder is the mock recorder for Mockstorage.
+type MockstorageMockRecorder struct {
+	mock *Mockstorage
+}
+
+// NewMockstorage creates a new mock instance.
+func NewMockstorage(ctrl *gomock.Controller) *Mockstorage {
+	mock := &Mockstorage{ctrl: ctrl}
+	mock.recorder = &MockstorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockstorage) EXPECT() *MockstorageMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *Mockstorage) Fetch() ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockstorageMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockstorage)(nil).Fetch))
+}
+
+// FetchByUuid mocks base method.
+func (m *Mockstorage) FetchByUuid(uuid string) (model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByUuid", uuid)
+	ret0, _ := ret[0].(model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByUuid indicates an expected call of FetchByUuid.
+func (mr *MockstorageMockRecorder) FetchByUuid(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByUuid", reflect.TypeOf((*Mockstorage)(nil).FetchByUuid), uuid)
+}
+
+// SetInteractions mocks base method.
+func (m *Mockstorage) SetInteractions(uuid string, interactions []model.Interaction, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInteractions", uuid, interactions, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInteractions indicates an expected call of SetInteractions.
+func (mr *MockstorageMockRecorder) SetInteractions(uuid, interactions, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInteractions", reflect.TypeOf((*Mockstorage)(nil).SetInteractions), uuid, interactions, updatedAt)
+}
+
+// SetKeepInTouch mocks base method.
+func (m *Mockstorage) SetKeepInTouch(uuid string, days int, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKeepInTouch", uuid, days, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKeepInTouch indicates an expected call of SetKeepInTouch.
+func (mr *MockstorageMockRecorder) SetKeepInTouch(uuid, days, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKeepInTouch", reflect.TypeOf((*Mockstorage)(nil).SetKeepInTouch), uuid, days, updatedAt)
+}
+
+// Mockuuid is a mock of uuid interface.
+type Mockuuid struct {
+	ctrl     *gomock.Controller
+	recorder *MockuuidMockRecorder
+}
+
+// MockuuidMockRecorder is the mock recorder for Mockuuid.
+type MockuuidMockRecorder struct {
+	mock *Mockuuid
+}
+
+// NewMockuuid creates a new mock instance.
+func NewMockuuid(ctrl *gomock.Controller) *Mockuuid {
+	mock := &Mockuuid{ctrl: ctrl}
+	mock.recorder = &MockuuidMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockuuid) EXPECT() *MockuuidMockRecorder {
+	return m.recorder
+}
+
+// NewString mocks base method.
+func (m *Mockuuid) NewString() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewString")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NewString indicates an expected call of NewString.
+func (mr *MockuuidMockRecorder) NewString() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewString", reflect.TypeOf((*Mockuuid)(nil).NewString))
+}
+
+// Mockdates is a mock of dates interface.
+type Mockdates struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatesMockRecorder
+}
+
+// MockdatesMockRecorder is the mock recorder for Mockdates.
+type MockdatesMockRecorder struct {
+	mock *Mockdates
+}
+
+// NewMockdates creates a new mock instance.
+func NewMockdates(ctrl *gomock.Controller) *Mockdates {
+	mock := &Mockdates{ctrl: ctrl}
+	mock.recorder = &MockdatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdates) EXPECT() *MockdatesMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *Mockdates) Parse(value string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", value)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockdatesMockRecorder) Parse(value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*Mockdates)(nil).Parse), value)
+}
+
+// Mocksorter is a mock of sorter interface.
+type Mocksorter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksorterMockRecorder
+}
+
+// MocksorterMockRecorder is the mock recorder for Mocksorter.
+type MocksorterMockRecorder struct {
+	mock *Mocksorter
+}
+
+// NewMocksorter creates a new mock instance.
+func NewMocksorter(ctrl *gomock.Controller) *Mocksorter {
+	mock := &Mocksorter{ctrl: ctrl}
+	mock.recorder = &MocksorterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksorter) EXPECT() *MocksorterMockRecorder {
+	return m.recorder
+}
+
+// Sort mocks base method.
+func (m *Mocksorter) Sort(contacts []model.Contact, key model.SortKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Sort", contacts, key)
+}
+
+// Sort indicates an expected call of Sort.
+func (mr *MocksorterMockRecorder) Sort(contacts, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sort", reflect.TypeOf((*Mocksorter)(nil).Sort), contacts, key)
+}
+
+// Mockclock is a mock of clock interface.
+type Mockclock struct {
+	ctrl     *gomock.Controller
+	recorder *MockclockMockRecorder
+}
+
+// MockclockMockRecorder is the mock recorder for Mockclock.
+type MockclockMockRecorder struct {
+	mock *Mockclock
+}
+
+// NewMockclock creates a new mock instance.
+func NewMockclock(ctrl *gomock.Controller) *Mockclock {
+	mock := &Mockclock{ctrl: ctrl}
+	mock.recorder = &MockclockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclock) EXPECT() *MockclockMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *Mockclock) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockclockMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*Mockclock)(nil).Now))
+}
@@ -0,0 +1,17 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package merge
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+type storage interface {
+	FetchByUuid(uuid string) (model.Contact, error)
+	Merge(merged model.Contact, sourceUUID string) error
+}
+
+type clock interface {
+	Now() time.Time
+}
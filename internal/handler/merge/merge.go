@@ -0,0 +1,185 @@
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"contacts/internal/model"
+)
+
+type Handler struct {
+	storage storage
+	clock   clock
+}
+
+func NewHandler(s storage, c clock) *Handler {
+	return &Handler{
+		storage: s,
+		clock:   c,
+	}
+}
+
+// Merge – объединяет два контакта в один.
+//
+// Значения полей берутся из target, если в запросе не выбран source или поле в target пустое.
+// Организация, отдел и должность берутся вместе из одного контакта – по выбору для организации.
+// Ссылки, метки, связи и журнал общения объединяются, контакт остается избранным, если избранным был любой из двух.
+// Частота общения – более частая из заданных.
+// Дата создания – более ранняя из двух, дата изменения – время объединения, источник – у target.
+// После объединения source удаляется.
+//
+// Если после объединения телефон или email совпадает с другим контактом и это запрещено,
+// возвращается *model.UniqueViolationError. Предупреждения об уникальности не считаются ошибкой.
+func (h *Handler) Merge(_ context.Context, request model.MergeRequest) (model.Contact, error) {
+	if request.TargetUUID == request.SourceUUID {
+		return model.Contact{}, errors.New("can't merge contact with itself")
+	}
+
+	target, err := h.storage.FetchByUuid(request.TargetUUID)
+	if err != nil {
+		return model.Contact{}, fmt.Errorf("fetch target: %w", err)
+	}
+
+	source, err := h.storage.FetchByUuid(request.SourceUUID)
+	if err != nil {
+		return model.Contact{}, fmt.Errorf("fetch source: %w", err)
+	}
+
+	merged := model.Contact{
+		UUID:     target.UUID,
+		Surname:  pick(request.Choices[model.FieldSurname], target.Surname, source.Surname),
+		Name:     pick(request.Choices[model.FieldName], target.Name, source.Name),
+		Birthday: pick(request.Choices[model.FieldBirthday], target.Birthday, source.Birthday),
+		Phone:    pick(request.Choices[model.FieldPhone], target.Phone, source.Phone),
+		Email:    pick(request.Choices[model.FieldEmail], target.Email, source.Email),
+		Links:    make(map[model.ContactLink]string, len(target.Links)+len(source.Links)),
+		Avatar:   pick(request.Choices[model.FieldAvatar], target.Avatar, source.Avatar),
+		Favorite: target.Favorite || source.Favorite,
+		Notes:    pick(request.Choices[model.FieldNotes], target.Notes, source.Notes),
+
+		KeepInTouch: shortest(target.KeepInTouch, source.KeepInTouch),
+
+		CreatedAt: earliest(target.CreatedAt, source.CreatedAt),
+		UpdatedAt: h.clock.Now(),
+		Source:    target.Source,
+	}
+
+	// Место работы не смешиваем: отдел и должность имеют смысл только вместе с организацией
+	work := target
+	if workSide(request.Choices[model.FieldOrganization], target, source) == model.MergeSideSource {
+		work = source
+	}
+
+	merged.OrganizationUUID = work.OrganizationUUID
+	merged.Department = work.Department
+	merged.Title = work.Title
+
+	// Метки объединяются без повторов, сначала метки target
+	for _, tag := range slices.Concat(target.Tags, source.Tags) {
+		if !slices.Contains(merged.Tags, tag) {
+			merged.Tags = append(merged.Tags, tag)
+		}
+	}
+
+	// Связи объединяются без повторов, связи двух контактов друг с другом пропадают
+	for _, relation := range slices.Concat(target.Relations, source.Relations) {
+		if relation.UUID == target.UUID || relation.UUID == source.UUID || slices.Contains(merged.Relations, relation) {
+			continue
+		}
+
+		merged.Relations = append(merged.Relations, relation)
+	}
+
+	// Журнал общения объединяется без повторов, последние записи – первые
+	for _, interaction := range slices.Concat(target.Interactions, source.Interactions) {
+		if !slices.ContainsFunc(merged.Interactions, func(i model.Interaction) bool { return i.UUID == interaction.UUID }) {
+			merged.Interactions = append(merged.Interactions, interaction)
+		}
+	}
+
+	slices.SortStableFunc(merged.Interactions, func(a, b model.Interaction) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	for link, value := range source.Links {
+		merged.Links[link] = value
+	}
+
+	for link, value := range target.Links {
+		merged.Links[link] = pick(request.Choices[model.Field(link)], value, source.Links[link])
+	}
+
+	err = h.storage.Merge(merged, source.UUID)
+	if err != nil {
+		var violation *model.UniqueViolationError
+		if errors.As(err, &violation) && !violation.Blocked {
+			return merged, nil
+		}
+
+		return model.Contact{}, fmt.Errorf("merge: %w", err)
+	}
+
+	return merged, nil
+}
+
+// pick – выбирает значение поля для объединенного контакта
+func pick[T comparable](side model.MergeSide, target, source T) T {
+	var zero T
+
+	switch side {
+	case model.MergeSideSource:
+		return source
+	case model.MergeSideTarget:
+		return target
+	}
+
+	// Выбор не сделан – берем непустое значение, target в приоритете
+	if target == zero {
+		return source
+	}
+
+	return target
+}
+
+// workSide – контакт, из которого берется место работы.
+// Без выбора предпочитаем контакт с организацией, затем с отделом или должностью, target в приоритете
+func workSide(side model.MergeSide, target, source model.Contact) model.MergeSide {
+	switch side {
+	case model.MergeSideSource, model.MergeSideTarget:
+		return side
+	}
+
+	switch {
+	case target.OrganizationUUID != "":
+		return model.MergeSideTarget
+	case source.OrganizationUUID != "":
+		return model.MergeSideSource
+	case target.Department != "" || target.Title != "":
+		return model.MergeSideTarget
+	case source.Department != "" || source.Title != "":
+		return model.MergeSideSource
+	}
+
+	return model.MergeSideTarget
+}
+
+// earliest – более ранняя из дат, неизвестная дата не учитывается
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+
+	return a
+}
+
+// shortest – меньший из интервалов, 0 – интервал не задан
+func shortest(a, b int) int {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,307 @@
+package merge_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	. "contacts/internal/handler/merge"
+	"contacts/internal/model"
+)
+
+func TestHandler_Merge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	target := model.Contact{
+		UUID:     "1",
+		Surname:  "Ершов",
+		Name:     "Виталий",
+		Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
+		Phone:    model.NewPhoneFromInt64(79151596781),
+		Links: map[model.ContactLink]string{
+			model.ContactLinkVk: "https://vk.com/target",
+		},
+		Title: "Разработчик",
+		Relations: []model.Relation{
+			{Type: model.RelationColleague, UUID: "3"},
+		},
+		Interactions: []model.Interaction{
+			{UUID: "i2", Type: model.InteractionCall, Date: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)},
+			{UUID: "i1", Type: model.InteractionMeeting, Date: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		KeepInTouch: 30,
+		CreatedAt:   time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Source:      model.SourceManual,
+	}
+
+	source := model.Contact{
+		UUID:     "2",
+		Surname:  "Ершов",
+		Name:     "Виталя",
+		Birthday: time.Date(2001, 1, 11, 0, 0, 0, 0, time.UTC),
+		Phone:    model.NewPhoneFromInt64(79165765731),
+		Email:    "vaershov@avito.ru",
+		Links: map[model.ContactLink]string{
+			model.ContactLinkVk: "https://vk.com/source",
+		},
+		Avatar: "hash",
+		Notes:  "Познакомились на GopherCon",
+
+		OrganizationUUID: "avito",
+		Department:       "Платформа",
+		Title:            "Тимлид",
+
+		Relations: []model.Relation{
+			{Type: model.RelationColleague, UUID: "3"},
+			{Type: model.RelationSpouse, UUID: "1"},
+			{Type: model.RelationManager, UUID: "4"},
+		},
+		Interactions: []model.Interaction{
+			{UUID: "i3", Type: model.InteractionMessage, Date: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+			{UUID: "i1", Type: model.InteractionMeeting, Date: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		KeepInTouch: 14,
+		CreatedAt:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Source:      model.SourceImportVCard,
+	}
+
+	request := model.MergeRequest{
+		TargetUUID: "1",
+		SourceUUID: "2",
+		Choices: map[model.Field]model.MergeSide{
+			model.FieldBirthday:              model.MergeSideSource,
+			model.FieldPhone:                 model.MergeSideTarget,
+			model.Field(model.ContactLinkVk): model.MergeSideSource,
+		},
+	}
+
+	merged := model.Contact{
+		UUID:     "1",
+		Surname:  "Ершов",
+		Name:     "Виталий",
+		Birthday: time.Date(2001, 1, 11, 0, 0, 0, 0, time.UTC),
+		Phone:    model.NewPhoneFromInt64(79151596781),
+		Email:    "vaershov@avito.ru",
+		Links: map[model.ContactLink]string{
+			model.ContactLinkVk: "https://vk.com/source",
+		},
+		Avatar: "hash",
+		Notes:  "Познакомились на GopherCon",
+
+		OrganizationUUID: "avito",
+		Department:       "Платформа",
+		Title:            "Тимлид",
+
+		Relations: []model.Relation{
+			{Type: model.RelationColleague, UUID: "3"},
+			{Type: model.RelationManager, UUID: "4"},
+		},
+		Interactions: []model.Interaction{
+			{UUID: "i2", Type: model.InteractionCall, Date: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)},
+			{UUID: "i3", Type: model.InteractionMessage, Date: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+			{UUID: "i1", Type: model.InteractionMeeting, Date: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		KeepInTouch: 14,
+		CreatedAt:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   now,
+		Source:      model.SourceManual,
+	}
+
+	tests := []struct {
+		name         string
+		request      model.MergeRequest
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, actual model.Contact, err error)
+	}{
+		{
+			name: "Merge with itself",
+			request: model.MergeRequest{
+				TargetUUID: "1",
+				SourceUUID: "1",
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "Failed to fetch target",
+			request: request,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{}, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "Failed to fetch source",
+			request: request,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(model.Contact{}, model.ErrNotFound)
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:    "Failed to merge",
+			request: request,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				storage.EXPECT().
+					Merge(merged, "2").
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "Blocked by unique index",
+			request: request,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				storage.EXPECT().
+					Merge(merged, "2").
+					Return(&model.UniqueViolationError{
+						Fields:  map[model.Field]model.Message{model.FieldEmail: model.NewMessage("msg", nil)},
+						Blocked: true,
+					})
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.ErrorIs(t, err, model.ErrNotUnique)
+			},
+		},
+		{
+			name:    "Unique warning is not an error",
+			request: request,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				storage.EXPECT().
+					Merge(merged, "2").
+					Return(&model.UniqueViolationError{
+						Fields: map[model.Field]model.Message{model.FieldEmail: model.NewMessage("msg", nil)},
+					})
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, merged, actual)
+			},
+		},
+		{
+			name: "Department and title follow organization choice",
+			request: model.MergeRequest{
+				TargetUUID: "1",
+				SourceUUID: "2",
+				Choices: map[model.Field]model.MergeSide{
+					model.FieldOrganization: model.MergeSideTarget,
+					model.FieldDepartment:   model.MergeSideSource,
+					model.FieldTitle:        model.MergeSideSource,
+				},
+			},
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				storage.EXPECT().
+					Merge(gomock.Any(), "2").
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "", actual.OrganizationUUID)
+				assert.Equal(t, "", actual.Department)
+				assert.Equal(t, "Разработчик", actual.Title)
+			},
+		},
+		{
+			name:    "Success",
+			request: request,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				storage.EXPECT().
+					Merge(merged, "2").
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual model.Contact, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, merged, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, mockClock)
+
+			out, err := instance.Merge(context.Background(), tc.request)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
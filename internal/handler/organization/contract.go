@@ -0,0 +1,35 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package organization
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+type organizations interface {
+	Fetch() ([]model.Organization, error)
+	FetchByUuid(uuid string) (model.Organization, error)
+	FetchByName(name string) (model.Organization, error)
+	Create(organization model.Organization) error
+	Update(organization model.Organization) error
+	Delete(uuid string) error
+}
+
+type contacts interface {
+	Fetch() ([]model.Contact, error)
+	UpdateMany(contacts []model.Contact) error
+}
+
+type sorter interface {
+	Sort(contacts []model.Contact, key model.SortKey)
+	SortOrganizations(organizations []model.Organization)
+}
+
+type uuid interface {
+	NewString() string
+}
+
+type clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,301 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source contract.go -destination mocks_test.go -package organization_test
+//
+
+// Package organization_test is a generated GoMock package.
+package organization_test
+
+import (
+	model "contacts/internal/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockorganizations is a mock of organizations interface.
+type Mockorganizations struct {
+	ctrl     *gomock.Controller
+	recorder *MockorganizationsMockRecorder
+}
+
+// MockorganizationsMockRecorder is the mock recorder for Mockorganizations.
+type MockorganizationsMockRecorder struct {
+	mock *Mockorganizations
+}
+
+// NewMockorganizations creates a new mock instance.
+func NewMockorganizations(ctrl *gomock.Controller) *Mockorganizations {
+	mock := &Mockorganizations{ctrl: ctrl}
+	mock.recorder = &MockorganizationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockorganizations) EXPECT() *MockorganizationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *Mockorganizations) Create(organization model.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockorganizationsMockRecorder) Create(organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*Mockorganizations)(nil).Create), organization)
+}
+
+// Delete mocks base method.
+func (m *Mockorganizations) Delete(uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockorganizationsMockRecorder) Delete(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Mockorganizations)(nil).Delete), uuid)
+}
+
+// Fetch mocks base method.
+func (m *Mockorganizations) Fetch() ([]model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].([]model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockorganizationsMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockorganizations)(nil).Fetch))
+}
+
+// FetchByName mocks base method.
+func (m *Mockorganizations) FetchByName(name string) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByName", name)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByName indicates an expected call of FetchByName.
+func (mr *MockorganizationsMockRecorder) FetchByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByName", reflect.TypeOf((*Mockorganizations)(nil).FetchByName), name)
+}
+
+// FetchByUuid mocks base method.
+func (m *Mockorganizations) FetchByUuid(uuid string) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByUuid", uuid)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByUuid indicates an expected call of FetchByUuid.
+func (mr *MockorganizationsMockRecorder) FetchByUuid(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByUuid", reflect.TypeOf((*Mockorganizations)(nil).FetchByUuid), uuid)
+}
+
+// Update mocks base method.
+func (m *Mockorganizations) Update(organization model.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockorganizationsMockRecorder) Update(organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*Mockorganizations)(nil).Update), organization)
+}
+
+// Mockcontacts is a mock of contacts interface.
+type Mockcontacts struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontactsMockRecorder
+}
+
+// MockcontactsMockRecorder is the mock recorder for Mockcontacts.
+type MockcontactsMockRecorder struct {
+	mock *Mockcontacts
+}
+
+// NewMockcontacts creates a new mock instance.
+func NewMockcontacts(ctrl *gomock.Controller) *Mockcontacts {
+	mock := &Mockcontacts{ctrl: ctrl}
+	mock.recorder = &MockcontactsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockcontacts) EXPECT() *MockcontactsMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *Mockcontacts) Fetch() ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch")
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockcontactsMockRecorder) Fetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockcontacts)(nil).Fetch))
+}
+
+// UpdateMany mocks base method.
+func (m *Mockcontacts) UpdateMany(contacts []model.Contact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMany", contacts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMany indicates an expected call of UpdateMany.
+func (mr *MockcontactsMockRecorder) UpdateMany(contacts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMany", reflect.TypeOf((*Mockcontacts)(nil).UpdateMany), contacts)
+}
+
+// Mocksorter is a mock of sorter interface.
+type Mocksorter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksorterMockRecorder
+}
+
+// MocksorterMockRecorder is the mock recorder for Mocksorter.
+type MocksorterMockRecorder struct {
+	mock *Mocksorter
+}
+
+// NewMocksorter creates a new mock instance.
+func NewMocksorter(ctrl *gomock.Controller) *Mocksorter {
+	mock := &Mocksorter{ctrl: ctrl}
+	mock.recorder = &MocksorterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksorter) EXPECT() *MocksorterMockRecorder {
+	return m.recorder
+}
+
+// Sort mocks base method.
+func (m *Mocksorter) Sort(contacts []model.Contact, key model.SortKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Sort", contacts, key)
+}
+
+// Sort indicates an expected call of Sort.
+func (mr *MocksorterMockRecorder) Sort(contacts, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sort", reflect.TypeOf((*Mocksorter)(nil).Sort), contacts, key)
+}
+
+// SortOrganizations mocks base method.
+func (m *Mocksorter) SortOrganizations(organizations []model.Organization) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SortOrganizations", organizations)
+}
+
+// SortOrganizations indicates an expected call of SortOrganizations.
+func (mr *MocksorterMockRecorder) SortOrganizations(organizations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SortOrganizations", reflect.TypeOf((*Mocksorter)(nil).SortOrganizations), organizations)
+}
+
+// Mockuuid is a mock of uuid interface.
+type Mockuuid struct {
+	ctrl     *gomock.Controller
+	recorder *MockuuidMockRecorder
+}
+
+// MockuuidMockRecorder is the mock recorder for Mockuuid.
+type MockuuidMockRecorder struct {
+	mock *Mockuuid
+}
+
+// NewMockuuid creates a new mock instance.
+func NewMockuuid(ctrl *gomock.Controller) *Mockuuid {
+	mock := &Mockuuid{ctrl: ctrl}
+	mock.recorder = &MockuuidMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockuuid) EXPECT() *MockuuidMockRecorder {
+	return m.recorder
+}
+
+// NewString mocks base method.
+func (m *Mockuuid) NewString() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewString")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NewString indicates an expected call of NewString.
+func (mr *MockuuidMockRecorder) NewString() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewString", reflect.TypeOf((*Mockuuid)(nil).NewString))
+}
+
+// Mockclock is a mock of clock interface.
+type Mockclock struct {
+	ctrl     *gomock.Controller
+	recorder *MockclockMockRecorder
+}
+
+// MockclockMockRecorder is the mock recorder for Mockclock.
+type MockclockMockRecorder struct {
+	mock *Mockclock
+}
+
+// NewMockclock creates a new mock instance.
+func NewMockclock(ctrl *gomock.Controller) *Mockclock {
+	mock := &Mockclock{ctrl: ctrl}
+	mock.recorder = &MockclockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclock) EXPECT() *MockclockMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *Mockclock) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockclockMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*Mockclock)(nil).Now))
+}
@@ -0,0 +1,210 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"contacts/internal/model"
+)
+
+type Handler struct {
+	organizations organizations
+	contacts      contacts
+	uuid          uuid
+	sorter        sorter
+	clock         clock
+}
+
+func NewHandler(o organizations, c contacts, uuid uuid, s sorter, clock clock) *Handler {
+	return &Handler{
+		organizations: o,
+		contacts:      c,
+		uuid:          uuid,
+		sorter:        s,
+		clock:         clock,
+	}
+}
+
+// List – все организации в порядке названия
+func (h *Handler) List(_ context.Context) ([]model.Organization, error) {
+	organizations, err := h.organizations.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+
+	h.sorter.SortOrganizations(organizations)
+
+	return organizations, nil
+}
+
+// Create – создать организацию с названием name.
+//
+// Пустое название – model.ErrValidation, занятое – model.ErrAlreadyExists.
+func (h *Handler) Create(_ context.Context, name string) (model.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return model.Organization{}, fmt.Errorf("%w: empty organization name", model.ErrValidation)
+	}
+
+	organization := model.Organization{
+		UUID: h.uuid.NewString(),
+		Name: name,
+	}
+
+	err := h.organizations.Create(organization)
+	if err != nil {
+		return model.Organization{}, fmt.Errorf("create: %w", err)
+	}
+
+	return organization, nil
+}
+
+// SaveWithOrganization – сохраняет контакт вместе с организацией по названию name.
+//
+// Организация ищется по названию без учета регистра, если такой нет – создается.
+// save сохраняет контакт со ссылкой на организацию, пустой UUID – организация не указана.
+// Если контакт не сохранен, только что созданная организация удаляется, ошибка save возвращается как есть.
+// Предупреждение об уникальности значит, что контакт сохранен, организация остается.
+func (h *Handler) SaveWithOrganization(_ context.Context, name string, save func(organizationUUID string) error) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return save("")
+	}
+
+	organization, err := h.organizations.FetchByName(name)
+	if err == nil {
+		return save(organization.UUID)
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		return fmt.Errorf("fetch by name: %w", err)
+	}
+
+	organization = model.Organization{
+		UUID: h.uuid.NewString(),
+		Name: name,
+	}
+
+	err = h.organizations.Create(organization)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = save(organization.UUID)
+	if err == nil {
+		return nil
+	}
+
+	var violation *model.UniqueViolationError
+	if errors.As(err, &violation) && !violation.Blocked {
+		return err
+	}
+
+	// Контакт не сохранен, новая организация ему не понадобилась
+	deleteErr := h.organizations.Delete(organization.UUID)
+	if deleteErr != nil {
+		return errors.Join(err, fmt.Errorf("delete organization: %w", deleteErr))
+	}
+
+	return err
+}
+
+// Rename – переименовать организацию, у контактов она меняется сразу, т.к. они ссылаются на UUID
+func (h *Handler) Rename(_ context.Context, uuid, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: empty organization name", model.ErrValidation)
+	}
+
+	organization, err := h.organizations.FetchByUuid(uuid)
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	if organization.Name == name {
+		return nil
+	}
+
+	organization.Name = name
+
+	err = h.organizations.Update(organization)
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+
+	return nil
+}
+
+// Merge – объединяет организацию source с target.
+//
+// Сотрудники source переходят в target, после чего source удаляется.
+// Если удалить source не удалось, сотрудники уже в target и повторный Merge безопасен.
+func (h *Handler) Merge(_ context.Context, targetUUID, sourceUUID string) error {
+	if targetUUID == sourceUUID {
+		return errors.New("can't merge organization with itself")
+	}
+
+	_, err := h.organizations.FetchByUuid(targetUUID)
+	if err != nil {
+		return fmt.Errorf("fetch target: %w", err)
+	}
+
+	_, err = h.organizations.FetchByUuid(sourceUUID)
+	if err != nil {
+		return fmt.Errorf("fetch source: %w", err)
+	}
+
+	members, err := h.members(sourceUUID)
+	if err != nil {
+		return err
+	}
+
+	if len(members) > 0 {
+		now := h.clock.Now()
+		for i := range members {
+			members[i].OrganizationUUID = targetUUID
+			members[i].UpdatedAt = now
+		}
+
+		err = h.contacts.UpdateMany(members)
+		if err != nil {
+			return fmt.Errorf("update members: %w", err)
+		}
+	}
+
+	err = h.organizations.Delete(sourceUUID)
+	if err != nil {
+		return fmt.Errorf("delete source: %w", err)
+	}
+
+	return nil
+}
+
+// Members – контакты организации в порядке фамилии и имени
+func (h *Handler) Members(_ context.Context, uuid string) ([]model.Contact, error) {
+	members, err := h.members(uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	h.sorter.Sort(members, model.SortKeySurname)
+
+	return members, nil
+}
+
+func (h *Handler) members(uuid string) ([]model.Contact, error) {
+	contacts, err := h.contacts.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch contacts: %w", err)
+	}
+
+	members := make([]model.Contact, 0)
+	for _, contact := range contacts {
+		if contact.OrganizationUUID == uuid {
+			members = append(members, contact)
+		}
+	}
+
+	return members, nil
+}
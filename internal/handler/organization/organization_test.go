@@ -0,0 +1,675 @@
+package organization_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"contacts/internal/domain/order"
+	. "contacts/internal/handler/organization"
+	"contacts/internal/model"
+)
+
+func TestHandler_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		prepare      func(organizations *Mockorganizations)
+		expectations func(t assert.TestingT, actual []model.Organization, err error)
+	}{
+		{
+			name: "Failed to fetch",
+			prepare: func(organizations *Mockorganizations) {
+				organizations.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []model.Organization, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			name: "Sorted by Russian alphabet ignoring case",
+			prepare: func(organizations *Mockorganizations) {
+				organizations.EXPECT().
+					Fetch().
+					Return([]model.Organization{
+						{UUID: "1", Name: "яндекс"},
+						{UUID: "2", Name: "Авито"},
+						{UUID: "3", Name: "Газпром"},
+						{UUID: "4", Name: "Ёлки-Палки"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.Organization, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []model.Organization{
+					{UUID: "2", Name: "Авито"},
+					{UUID: "3", Name: "Газпром"},
+					{UUID: "4", Name: "Ёлки-Палки"},
+					{UUID: "1", Name: "яндекс"},
+				}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockOrganizations := NewMockorganizations(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockOrganizations)
+			}
+
+			instance := NewHandler(mockOrganizations, NewMockcontacts(ctrl), NewMockuuid(ctrl), order.NewSorter(language.Russian), NewMockclock(ctrl))
+
+			out, err := instance.List(context.Background())
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
+
+func TestHandler_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		prepare      func(organizations *Mockorganizations, uuid *Mockuuid)
+		expectations func(t assert.TestingT, actual model.Organization, err error)
+	}{
+		{
+			name:  "Empty name",
+			input: "  ",
+			expectations: func(t assert.TestingT, actual model.Organization, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:  "Name already taken",
+			input: "Авито",
+			prepare: func(organizations *Mockorganizations, uuid *Mockuuid) {
+				uuid.EXPECT().
+					NewString().
+					Return("1")
+
+				organizations.EXPECT().
+					Create(model.Organization{UUID: "1", Name: "Авито"}).
+					Return(model.ErrAlreadyExists)
+			},
+			expectations: func(t assert.TestingT, actual model.Organization, err error) {
+				assert.ErrorIs(t, err, model.ErrAlreadyExists)
+			},
+		},
+		{
+			name:  "Success",
+			input: " Авито ",
+			prepare: func(organizations *Mockorganizations, uuid *Mockuuid) {
+				uuid.EXPECT().
+					NewString().
+					Return("1")
+
+				organizations.EXPECT().
+					Create(model.Organization{UUID: "1", Name: "Авито"}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual model.Organization, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, model.Organization{UUID: "1", Name: "Авито"}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockOrganizations := NewMockorganizations(ctrl)
+			mockUuid := NewMockuuid(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockOrganizations, mockUuid)
+			}
+
+			instance := NewHandler(mockOrganizations, NewMockcontacts(ctrl), mockUuid, order.NewSorter(language.Russian), NewMockclock(ctrl))
+
+			out, err := instance.Create(context.Background(), tc.input)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
+
+func TestHandler_SaveWithOrganization(t *testing.T) {
+	t.Parallel()
+
+	blocked := &model.UniqueViolationError{
+		Fields:  map[model.Field]model.Message{model.FieldPhone: model.NewMessage("msg", nil)},
+		Blocked: true,
+	}
+	warning := &model.UniqueViolationError{
+		Fields: map[model.Field]model.Message{model.FieldPhone: model.NewMessage("msg", nil)},
+	}
+
+	tests := []struct {
+		name         string
+		organization string
+		saveErr      error
+		prepare      func(organizations *Mockorganizations, uuid *Mockuuid)
+		expectations func(t assert.TestingT, saved []string, err error)
+	}{
+		{
+			name:         "Without organization",
+			organization: " ",
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{""}, saved)
+			},
+		},
+		{
+			name:         "Existing organization is found by name",
+			organization: " авито ",
+			prepare: func(organizations *Mockorganizations, _ *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("авито").
+					Return(model.Organization{UUID: "avito", Name: "Авито"}, nil)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"avito"}, saved)
+			},
+		},
+		{
+			name:         "Failed to fetch organization",
+			organization: "Авито",
+			prepare: func(organizations *Mockorganizations, _ *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("Авито").
+					Return(model.Organization{}, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.Empty(t, saved)
+			},
+		},
+		{
+			name:         "Failed to create organization",
+			organization: "Авито",
+			prepare: func(organizations *Mockorganizations, uuid *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("Авито").
+					Return(model.Organization{}, model.ErrNotFound)
+
+				uuid.EXPECT().
+					NewString().
+					Return("avito")
+
+				organizations.EXPECT().
+					Create(model.Organization{UUID: "avito", Name: "Авито"}).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.Empty(t, saved)
+			},
+		},
+		{
+			name:         "New organization is created with the contact",
+			organization: "Авито",
+			prepare: func(organizations *Mockorganizations, uuid *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("Авито").
+					Return(model.Organization{}, model.ErrNotFound)
+
+				uuid.EXPECT().
+					NewString().
+					Return("avito")
+
+				organizations.EXPECT().
+					Create(model.Organization{UUID: "avito", Name: "Авито"}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"avito"}, saved)
+			},
+		},
+		{
+			name:         "New organization is kept when contact is saved with unique warning",
+			organization: "Авито",
+			saveErr:      warning,
+			prepare: func(organizations *Mockorganizations, uuid *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("Авито").
+					Return(model.Organization{}, model.ErrNotFound)
+
+				uuid.EXPECT().
+					NewString().
+					Return("avito")
+
+				organizations.EXPECT().
+					Create(model.Organization{UUID: "avito", Name: "Авито"}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.ErrorIs(t, err, warning)
+			},
+		},
+		{
+			name:         "New organization is removed when contact is blocked by unique index",
+			organization: "Авито",
+			saveErr:      blocked,
+			prepare: func(organizations *Mockorganizations, uuid *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("Авито").
+					Return(model.Organization{}, model.ErrNotFound)
+
+				uuid.EXPECT().
+					NewString().
+					Return("avito")
+
+				organizations.EXPECT().
+					Create(model.Organization{UUID: "avito", Name: "Авито"}).
+					Return(nil)
+
+				organizations.EXPECT().
+					Delete("avito").
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.ErrorIs(t, err, blocked)
+			},
+		},
+		{
+			name:         "Failed to remove new organization",
+			organization: "Авито",
+			saveErr:      assert.AnError,
+			prepare: func(organizations *Mockorganizations, uuid *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("Авито").
+					Return(model.Organization{}, model.ErrNotFound)
+
+				uuid.EXPECT().
+					NewString().
+					Return("avito")
+
+				organizations.EXPECT().
+					Create(model.Organization{UUID: "avito", Name: "Авито"}).
+					Return(nil)
+
+				organizations.EXPECT().
+					Delete("avito").
+					Return(model.ErrNotFound)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:         "Existing organization is kept when contact is not saved",
+			organization: "Авито",
+			saveErr:      assert.AnError,
+			prepare: func(organizations *Mockorganizations, _ *Mockuuid) {
+				organizations.EXPECT().
+					FetchByName("Авито").
+					Return(model.Organization{UUID: "avito", Name: "Авито"}, nil)
+			},
+			expectations: func(t assert.TestingT, saved []string, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.Equal(t, []string{"avito"}, saved)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockOrganizations := NewMockorganizations(ctrl)
+			mockUuid := NewMockuuid(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockOrganizations, mockUuid)
+			}
+
+			instance := NewHandler(mockOrganizations, NewMockcontacts(ctrl), mockUuid, order.NewSorter(language.Russian), NewMockclock(ctrl))
+
+			var saved []string
+			err := instance.SaveWithOrganization(context.Background(), tc.organization, func(organizationUUID string) error {
+				saved = append(saved, organizationUUID)
+				return tc.saveErr
+			})
+
+			tc.expectations(t, saved, err)
+		})
+	}
+}
+
+func TestHandler_Rename(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		uuid         string
+		input        string
+		prepare      func(organizations *Mockorganizations)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:  "Empty name",
+			uuid:  "1",
+			input: "",
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:  "Not found",
+			uuid:  "1",
+			input: "Авито",
+			prepare: func(organizations *Mockorganizations) {
+				organizations.EXPECT().
+					FetchByUuid("1").
+					Return(model.Organization{}, model.ErrNotFound)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:  "Same name",
+			uuid:  "1",
+			input: "Авито",
+			prepare: func(organizations *Mockorganizations) {
+				organizations.EXPECT().
+					FetchByUuid("1").
+					Return(model.Organization{UUID: "1", Name: "Авито"}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:  "Success",
+			uuid:  "1",
+			input: "Авито Тех",
+			prepare: func(organizations *Mockorganizations) {
+				organizations.EXPECT().
+					FetchByUuid("1").
+					Return(model.Organization{UUID: "1", Name: "Авито"}, nil)
+
+				organizations.EXPECT().
+					Update(model.Organization{UUID: "1", Name: "Авито Тех"}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockOrganizations := NewMockorganizations(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockOrganizations)
+			}
+
+			instance := NewHandler(mockOrganizations, NewMockcontacts(ctrl), NewMockuuid(ctrl), order.NewSorter(language.Russian), NewMockclock(ctrl))
+
+			err := instance.Rename(context.Background(), tc.uuid, tc.input)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestHandler_Merge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	target := model.Organization{UUID: "1", Name: "Авито"}
+	source := model.Organization{UUID: "2", Name: "Avito"}
+
+	contacts := []model.Contact{
+		{UUID: "a", Surname: "Ершов", OrganizationUUID: "2", Title: "Разработчик"},
+		{UUID: "b", Surname: "Никандров", OrganizationUUID: "1"},
+		{UUID: "c", Surname: "Агеев"},
+	}
+
+	tests := []struct {
+		name         string
+		targetUUID   string
+		sourceUUID   string
+		prepare      func(organizations *Mockorganizations, contacts *Mockcontacts)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:       "Merge with itself",
+			targetUUID: "1",
+			sourceUUID: "1",
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:       "Source not found",
+			targetUUID: "1",
+			sourceUUID: "2",
+			prepare: func(organizations *Mockorganizations, _ *Mockcontacts) {
+				organizations.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				organizations.EXPECT().
+					FetchByUuid("2").
+					Return(model.Organization{}, model.ErrNotFound)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:       "Failed to update members",
+			targetUUID: "1",
+			sourceUUID: "2",
+			prepare: func(organizations *Mockorganizations, contactsStorage *Mockcontacts) {
+				organizations.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				organizations.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				contactsStorage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				contactsStorage.EXPECT().
+					UpdateMany(gomock.Any()).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			name:       "Success",
+			targetUUID: "1",
+			sourceUUID: "2",
+			prepare: func(organizations *Mockorganizations, contactsStorage *Mockcontacts) {
+				organizations.EXPECT().
+					FetchByUuid("1").
+					Return(target, nil)
+
+				organizations.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				contactsStorage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				contactsStorage.EXPECT().
+					UpdateMany([]model.Contact{
+						{UUID: "a", Surname: "Ершов", OrganizationUUID: "1", Title: "Разработчик", UpdatedAt: now},
+					}).
+					Return(nil)
+
+				organizations.EXPECT().
+					Delete("2").
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:       "Source without members",
+			targetUUID: "2",
+			sourceUUID: "3",
+			prepare: func(organizations *Mockorganizations, contactsStorage *Mockcontacts) {
+				organizations.EXPECT().
+					FetchByUuid("2").
+					Return(source, nil)
+
+				organizations.EXPECT().
+					FetchByUuid("3").
+					Return(model.Organization{UUID: "3", Name: "Яндекс"}, nil)
+
+				contactsStorage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				organizations.EXPECT().
+					Delete("3").
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockOrganizations := NewMockorganizations(ctrl)
+			mockContacts := NewMockcontacts(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockOrganizations, mockContacts)
+			}
+
+			instance := NewHandler(mockOrganizations, mockContacts, NewMockuuid(ctrl), order.NewSorter(language.Russian), mockClock)
+
+			err := instance.Merge(context.Background(), tc.targetUUID, tc.sourceUUID)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestHandler_Members(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		uuid         string
+		prepare      func(contacts *Mockcontacts)
+		expectations func(t assert.TestingT, actual []model.Contact, err error)
+	}{
+		{
+			name: "Failed to fetch contacts",
+			uuid: "1",
+			prepare: func(contacts *Mockcontacts) {
+				contacts.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []model.Contact, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			name: "Sorted by surname and name",
+			uuid: "1",
+			prepare: func(contacts *Mockcontacts) {
+				contacts.EXPECT().
+					Fetch().
+					Return([]model.Contact{
+						{UUID: "a", Surname: "Никандров", OrganizationUUID: "1"},
+						{UUID: "b", Surname: "Ершов", Name: "Виталий", OrganizationUUID: "1"},
+						{UUID: "c", Surname: "Агеев", OrganizationUUID: "2"},
+						{UUID: "d", Surname: "Ершов", Name: "Антон", OrganizationUUID: "1"},
+						{UUID: "e", Surname: "Ёлкин", OrganizationUUID: "1"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.Contact, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []model.Contact{
+					{UUID: "e", Surname: "Ёлкин", OrganizationUUID: "1"},
+					{UUID: "d", Surname: "Ершов", Name: "Антон", OrganizationUUID: "1"},
+					{UUID: "b", Surname: "Ершов", Name: "Виталий", OrganizationUUID: "1"},
+					{UUID: "a", Surname: "Никандров", OrganizationUUID: "1"},
+				}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockContacts := NewMockcontacts(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockContacts)
+			}
+
+			instance := NewHandler(NewMockorganizations(ctrl), mockContacts, NewMockuuid(ctrl), order.NewSorter(language.Russian), NewMockclock(ctrl))
+
+			out, err := instance.Members(context.Background(), tc.uuid)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package relations
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+type storage interface {
+	Fetch() ([]model.Contact, error)
+	FetchByUuid(uuid string) (model.Contact, error)
+	SetRelations(uuid string, relations []model.Relation, updatedAt time.Time) error
+}
+
+type sorter interface {
+	Sort(contacts []model.Contact, key model.SortKey)
+}
+
+type clock interface {
+	Now() time.Time
+}
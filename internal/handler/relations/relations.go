@@ -0,0 +1,151 @@
+package relations
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"contacts/internal/model"
+)
+
+type Handler struct {
+	storage storage
+	sorter  sorter
+	clock   clock
+}
+
+func NewHandler(s storage, sorter sorter, c clock) *Handler {
+	return &Handler{
+		storage: s,
+		sorter:  sorter,
+		clock:   c,
+	}
+}
+
+// Graph – связи контакта с другими контактами.
+//
+// Сначала идут связи, которые хранятся у контакта, затем обратные связи от других контактов
+// в порядке фамилии и имени. Обратная связь, которая повторяет прямую, не показывается.
+func (h *Handler) Graph(_ context.Context, uuid string) ([]model.RelatedContact, error) {
+	contacts, err := h.storage.Fetch()
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+
+	byUUID := make(map[string]model.Contact, len(contacts))
+	for _, contact := range contacts {
+		byUUID[contact.UUID] = contact
+	}
+
+	contact, ok := byUUID[uuid]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+
+	graph := make([]model.RelatedContact, 0, len(contact.Relations))
+	known := make(map[model.Relation]struct{}, len(contact.Relations))
+
+	for _, relation := range contact.Relations {
+		related, ok := byUUID[relation.UUID]
+		if !ok {
+			continue
+		}
+
+		graph = append(graph, model.RelatedContact{
+			Type:    relation.Type,
+			Contact: related,
+		})
+		known[relation] = struct{}{}
+	}
+
+	h.sorter.Sort(contacts, model.SortKeySurname)
+
+	for _, other := range contacts {
+		for _, relation := range other.Relations {
+			if relation.UUID != uuid {
+				continue
+			}
+
+			inverse := model.Relation{Type: relation.Type.Inverse(), UUID: other.UUID}
+			if _, ok := known[inverse]; ok {
+				continue
+			}
+
+			graph = append(graph, model.RelatedContact{
+				Type:     inverse.Type,
+				Contact:  other,
+				Incoming: true,
+			})
+			known[inverse] = struct{}{}
+		}
+	}
+
+	return graph, nil
+}
+
+// Link – связать контакт с другим контактом.
+//
+// relationType – кем relatedUUID приходится контакту uuid. Если такая связь уже есть
+// у любого из двух контактов, ничего не меняется.
+func (h *Handler) Link(_ context.Context, uuid, relatedUUID string, relationType model.RelationType) error {
+	if !relationType.Valid() || uuid == relatedUUID {
+		return model.ErrValidation
+	}
+
+	contact, err := h.storage.FetchByUuid(uuid)
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	related, err := h.storage.FetchByUuid(relatedUUID)
+	if err != nil {
+		return fmt.Errorf("fetch related: %w", err)
+	}
+
+	relation := model.Relation{Type: relationType, UUID: relatedUUID}
+	inverse := model.Relation{Type: relationType.Inverse(), UUID: uuid}
+
+	if slices.Contains(contact.Relations, relation) || slices.Contains(related.Relations, inverse) {
+		return nil
+	}
+
+	err = h.storage.SetRelations(uuid, append(slices.Clone(contact.Relations), relation), h.clock.Now())
+	if err != nil {
+		return fmt.Errorf("set relations: %w", err)
+	}
+
+	return nil
+}
+
+// Unlink – удалить связь, которую вернул Graph.
+//
+// Обратная связь удаляется у связанного контакта, т.к. хранится у него.
+func (h *Handler) Unlink(_ context.Context, uuid string, related model.RelatedContact) error {
+	owner := uuid
+	relation := model.Relation{Type: related.Type, UUID: related.Contact.UUID}
+
+	if related.Incoming {
+		owner = related.Contact.UUID
+		relation = model.Relation{Type: related.Type.Inverse(), UUID: uuid}
+	}
+
+	contact, err := h.storage.FetchByUuid(owner)
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	relations := slices.DeleteFunc(slices.Clone(contact.Relations), func(stored model.Relation) bool {
+		return stored == relation
+	})
+
+	if len(relations) == len(contact.Relations) {
+		return nil
+	}
+
+	err = h.storage.SetRelations(owner, relations, h.clock.Now())
+	if err != nil {
+		return fmt.Errorf("set relations: %w", err)
+	}
+
+	return nil
+}
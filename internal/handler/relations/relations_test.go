@@ -0,0 +1,429 @@
+package relations_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"contacts/internal/domain/order"
+	. "contacts/internal/handler/relations"
+	"contacts/internal/model"
+)
+
+func TestHandler_Graph(t *testing.T) {
+	t.Parallel()
+
+	contact := model.Contact{
+		UUID:    "1",
+		Surname: "Ершов",
+		Relations: []model.Relation{
+			{Type: model.RelationSpouse, UUID: "2"},
+			{Type: model.RelationManager, UUID: "3"},
+		},
+	}
+	spouse := model.Contact{
+		UUID:    "2",
+		Surname: "Ершова",
+		Relations: []model.Relation{
+			{Type: model.RelationSpouse, UUID: "1"},
+		},
+	}
+	manager := model.Contact{
+		UUID:    "3",
+		Surname: "Никандров",
+	}
+	child := model.Contact{
+		UUID:    "4",
+		Surname: "Ершов",
+		Name:    "Максим",
+		Relations: []model.Relation{
+			{Type: model.RelationParent, UUID: "1"},
+		},
+	}
+	friend := model.Contact{
+		UUID:    "5",
+		Surname: "Агеев",
+		Relations: []model.Relation{
+			{Type: model.RelationIntroducedBy, UUID: "1"},
+		},
+	}
+	colleague := model.Contact{
+		UUID:    "6",
+		Surname: "Ёлкин",
+		Relations: []model.Relation{
+			{Type: model.RelationColleague, UUID: "1"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		uuid         string
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, actual []model.RelatedContact, err error)
+	}{
+		{
+			name: "Failed to fetch contacts",
+			uuid: "1",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual []model.RelatedContact, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Contact not found",
+			uuid: "7",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{contact}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.RelatedContact, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name: "Stored relations first, then inverse ones by surname in Russian alphabet",
+			uuid: "1",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{child, manager, contact, colleague, friend, spouse}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.RelatedContact, err error) {
+				assert.NoError(t, err)
+
+				assert.Equal(t, []model.RelatedContact{
+					{Type: model.RelationSpouse, Contact: spouse},
+					{Type: model.RelationManager, Contact: manager},
+					{Type: model.RelationIntroduced, Contact: friend, Incoming: true},
+					{Type: model.RelationColleague, Contact: colleague, Incoming: true},
+					{Type: model.RelationChild, Contact: child, Incoming: true},
+				}, actual)
+			},
+		},
+		{
+			name: "Inverse view from the manager",
+			uuid: "3",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return([]model.Contact{contact, manager}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.RelatedContact, err error) {
+				assert.NoError(t, err)
+
+				assert.Equal(t, []model.RelatedContact{
+					{Type: model.RelationReport, Contact: contact, Incoming: true},
+				}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, order.NewSorter(language.Russian), NewMockclock(ctrl))
+
+			out, err := instance.Graph(context.Background(), tc.uuid)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
+
+func TestHandler_Link(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		uuid         string
+		relatedUUID  string
+		relationType model.RelationType
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:         "Unknown relation type",
+			uuid:         "1",
+			relatedUUID:  "2",
+			relationType: "friend",
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:         "Contact related to itself",
+			uuid:         "1",
+			relatedUUID:  "1",
+			relationType: model.RelationColleague,
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:         "Related contact not found",
+			uuid:         "1",
+			relatedUUID:  "2",
+			relationType: model.RelationColleague,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1"}, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(model.Contact{}, model.ErrNotFound)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:         "Inverse relation is already stored",
+			uuid:         "1",
+			relatedUUID:  "2",
+			relationType: model.RelationChild,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1"}, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(model.Contact{
+						UUID: "2",
+						Relations: []model.Relation{
+							{Type: model.RelationParent, UUID: "1"},
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "Failed to save relations",
+			uuid:         "1",
+			relatedUUID:  "2",
+			relationType: model.RelationColleague,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1"}, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(model.Contact{UUID: "2"}, nil)
+
+				storage.EXPECT().
+					SetRelations("1", []model.Relation{
+						{Type: model.RelationColleague, UUID: "2"},
+					}, now).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:         "Success",
+			uuid:         "1",
+			relatedUUID:  "2",
+			relationType: model.RelationManager,
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{
+						UUID: "1",
+						Relations: []model.Relation{
+							{Type: model.RelationSpouse, UUID: "3"},
+						},
+					}, nil)
+
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(model.Contact{UUID: "2"}, nil)
+
+				storage.EXPECT().
+					SetRelations("1", []model.Relation{
+						{Type: model.RelationSpouse, UUID: "3"},
+						{Type: model.RelationManager, UUID: "2"},
+					}, now).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, order.NewSorter(language.Russian), mockClock)
+
+			err := instance.Link(context.Background(), tc.uuid, tc.relatedUUID, tc.relationType)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestHandler_Unlink(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		related      model.RelatedContact
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name: "Failed to fetch contact",
+			related: model.RelatedContact{
+				Type:    model.RelationSpouse,
+				Contact: model.Contact{UUID: "2"},
+			},
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{}, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Relation already removed",
+			related: model.RelatedContact{
+				Type:    model.RelationSpouse,
+				Contact: model.Contact{UUID: "2"},
+			},
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{UUID: "1"}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Stored relation",
+			related: model.RelatedContact{
+				Type:    model.RelationSpouse,
+				Contact: model.Contact{UUID: "2"},
+			},
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("1").
+					Return(model.Contact{
+						UUID: "1",
+						Relations: []model.Relation{
+							{Type: model.RelationSpouse, UUID: "2"},
+							{Type: model.RelationColleague, UUID: "3"},
+						},
+					}, nil)
+
+				storage.EXPECT().
+					SetRelations("1", []model.Relation{
+						{Type: model.RelationColleague, UUID: "3"},
+					}, now).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Inverse relation is removed from related contact",
+			related: model.RelatedContact{
+				Type:     model.RelationReport,
+				Contact:  model.Contact{UUID: "2"},
+				Incoming: true,
+			},
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					FetchByUuid("2").
+					Return(model.Contact{
+						UUID: "2",
+						Relations: []model.Relation{
+							{Type: model.RelationManager, UUID: "1"},
+						},
+					}, nil)
+
+				storage.EXPECT().
+					SetRelations("2", []model.Relation{}, now).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, order.NewSorter(language.Russian), mockClock)
+
+			err := instance.Unlink(context.Background(), "1", tc.related)
+
+			tc.expectations(t, err)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package tag
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+type storage interface {
+	Fetch() ([]model.Contact, error)
+	UpdateMany(contacts []model.Contact) error
+}
+
+type clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,81 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"contacts/internal/model"
+)
+
+type Handler struct {
+	storage storage
+	clock   clock
+}
+
+func NewHandler(s storage, c clock) *Handler {
+	return &Handler{
+		storage: s,
+		clock:   c,
+	}
+}
+
+// Assign – добавляет метку выбранным контактам за одно сохранение.
+//
+// Метка без учета регистра уже есть у контакта – контакт не меняется.
+func (h *Handler) Assign(_ context.Context, uuids []string, tag string) error {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return fmt.Errorf("%w: empty tag", model.ErrValidation)
+	}
+
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	contacts, err := h.storage.Fetch()
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	byUUID := make(map[string]model.Contact, len(contacts))
+	for _, contact := range contacts {
+		byUUID[contact.UUID] = contact
+	}
+
+	now := h.clock.Now()
+
+	updated := make([]model.Contact, 0, len(uuids))
+	for _, uuid := range uuids {
+		contact, ok := byUUID[uuid]
+		if !ok {
+			return fmt.Errorf("%s: %w", uuid, model.ErrNotFound)
+		}
+
+		if hasTag(contact.Tags, tag) {
+			continue
+		}
+
+		contact.Tags = append(slices.Clone(contact.Tags), tag)
+		contact.UpdatedAt = now
+		updated = append(updated, contact)
+	}
+
+	if len(updated) == 0 {
+		return nil
+	}
+
+	err = h.storage.UpdateMany(updated)
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+
+	return nil
+}
+
+func hasTag(tags []string, tag string) bool {
+	return slices.ContainsFunc(tags, func(existing string) bool {
+		return strings.EqualFold(existing, tag)
+	})
+}
@@ -0,0 +1,170 @@
+package tag_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	. "contacts/internal/handler/tag"
+	"contacts/internal/model"
+)
+
+func TestHandler_Assign(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	uuids := []string{"1", "2"}
+
+	contacts := []model.Contact{
+		{
+			UUID: "1",
+		},
+		{
+			UUID: "2",
+			Tags: []string{"Семья"},
+		},
+		{
+			UUID: "3",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		uuids        []string
+		tag          string
+		prepare      func(storage *Mockstorage)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:  "Empty tag",
+			uuids: uuids,
+			tag:   "  ",
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name:  "Nothing selected",
+			uuids: nil,
+			tag:   "семья",
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:  "Failed to fetch contacts",
+			uuids: uuids,
+			tag:   "семья",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:  "Contact not found",
+			uuids: []string{"1", "4"},
+			tag:   "семья",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:  "All contacts already have tag",
+			uuids: []string{"2"},
+			tag:   "семья",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:  "Failed to update contacts",
+			uuids: uuids,
+			tag:   "семья",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				storage.EXPECT().
+					UpdateMany(gomock.Any()).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:  "Success",
+			uuids: uuids,
+			tag:   " друзья ",
+			prepare: func(storage *Mockstorage) {
+				storage.EXPECT().
+					Fetch().
+					Return(contacts, nil)
+
+				storage.EXPECT().
+					UpdateMany([]model.Contact{
+						{
+							UUID:      "1",
+							Tags:      []string{"друзья"},
+							UpdatedAt: now,
+						},
+						{
+							UUID:      "2",
+							Tags:      []string{"Семья", "друзья"},
+							UpdatedAt: now,
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := NewMockstorage(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(mockStorage)
+			}
+
+			instance := NewHandler(mockStorage, mockClock)
+
+			err := instance.Assign(context.Background(), tc.uuids, tc.tag)
+
+			tc.expectations(t, err)
+		})
+	}
+}
@@ -1,12 +1,29 @@
 //go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
 package update
 
-import "contacts/internal/model"
+import (
+	"context"
+	"time"
+
+	"contacts/internal/model"
+)
 
 type storage interface {
 	Update(contact model.Contact) error
 }
 
+type organizations interface {
+	SaveWithOrganization(ctx context.Context, name string, save func(organizationUUID string) error) error
+}
+
 type validator interface {
-	Validate(contact model.ContactForCreate) map[model.Field]string
+	Validate(contact model.ContactForCreate) map[model.Field]model.Message
+}
+
+type dates interface {
+	Parse(value string) (time.Time, error)
+}
+
+type clock interface {
+	Now() time.Time
 }
@@ -4,31 +4,36 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"contacts/internal/model"
 )
 
 type Handler struct {
-	storage   storage
-	validator validator
+	storage       storage
+	organizations organizations
+	validator     validator
+	dates         dates
+	clock         clock
 }
 
-func NewHandler(s storage, v validator) *Handler {
+func NewHandler(s storage, o organizations, v validator, d dates, c clock) *Handler {
 	return &Handler{
-		storage:   s,
-		validator: v,
+		storage:       s,
+		organizations: o,
+		validator:     v,
+		dates:         d,
+		clock:         c,
 	}
 }
 
-func (h *Handler) Update(_ context.Context, contactForCreate model.ContactForCreate) (map[model.Field]string, error) {
+func (h *Handler) Update(ctx context.Context, contactForCreate model.ContactForCreate) (map[model.Field]model.Message, error) {
 	fieldMsgs := h.validator.Validate(contactForCreate)
 
 	if len(fieldMsgs) > 0 {
 		return fieldMsgs, model.ErrValidation
 	}
 
-	birthday, err := time.Parse("02.01.2006", contactForCreate.Birthday)
+	birthday, err := h.dates.Parse(contactForCreate.Birthday)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +55,35 @@ func (h *Handler) Update(_ context.Context, contactForCreate model.ContactForCre
 		Phone:    phone,
 		Email:    contactForCreate.Email,
 		Links:    contactForCreate.Links,
+		Avatar:   contactForCreate.Avatar,
+		Tags:     contactForCreate.Tags,
+		Favorite: contactForCreate.Favorite,
+		Notes:    contactForCreate.Notes,
+
+		Department: contactForCreate.Department,
+		Title:      contactForCreate.Title,
+
+		// Дату создания и источник хранилище оставляет прежними
+		UpdatedAt: h.clock.Now(),
 	}
 
-	err = h.storage.Update(contact)
+	// Организация ищется или создается только для прошедшего проверку контакта
+	err = h.organizations.SaveWithOrganization(ctx, contactForCreate.Organization, func(organizationUUID string) error {
+		contact.OrganizationUUID = organizationUUID
+		return h.storage.Update(contact)
+	})
 	if err != nil {
+		// Телефон или email уже принадлежат другому контакту
+		var violation *model.UniqueViolationError
+		if errors.As(err, &violation) && !violation.Blocked {
+			// Контакт сохранен, но пользователя нужно предупредить
+			return violation.Fields, model.ErrUniqueWarning
+		}
+
+		if errors.As(err, &violation) {
+			return violation.Fields, model.ErrValidation
+		}
+
 		return nil, fmt.Errorf("update: %w", err)
 	}
 
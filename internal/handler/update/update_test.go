@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"contacts/internal/domain/date"
 	. "contacts/internal/handler/update"
 	"contacts/internal/model"
 	"contacts/util/pointer"
@@ -16,6 +17,8 @@ import (
 func TestHandler_Update(t *testing.T) {
 	t.Parallel()
 
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
 	contact := model.ContactForCreate{
 		UUID:     pointer.To("1"),
 		Name:     "Виталий",
@@ -26,29 +29,30 @@ func TestHandler_Update(t *testing.T) {
 		Links: map[model.ContactLink]string{
 			model.ContactLinkVk: "vk.com",
 		},
+		Avatar: "hash",
 	}
 
 	tests := []struct {
 		name             string
 		contactForCreate model.ContactForCreate
-		prepare          func(storage *Mockstorage, validator *Mockvalidator)
-		expectations     func(t assert.TestingT, got map[model.Field]string, err error)
+		prepare          func(storage *Mockstorage, organizations *Mockorganizations, validator *Mockvalidator)
+		expectations     func(t assert.TestingT, got map[model.Field]model.Message, err error)
 	}{
 		{
 			name:             "Validation error",
 			contactForCreate: contact,
-			prepare: func(_ *Mockstorage, validator *Mockvalidator) {
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
 				validator.EXPECT().
 					Validate(contact).
-					Return(map[model.Field]string{
-						model.FieldName: "msg",
+					Return(map[model.Field]model.Message{
+						model.FieldName: model.NewMessage("msg", nil),
 					})
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.ErrorIs(t, err, model.ErrValidation)
 
-				expected := map[model.Field]string{
-					model.FieldName: "msg",
+				expected := map[model.Field]model.Message{
+					model.FieldName: model.NewMessage("msg", nil),
 				}
 
 				assert.Equal(t, expected, actual)
@@ -58,19 +62,19 @@ func TestHandler_Update(t *testing.T) {
 			name: "Failed to parse birthday from string",
 			contactForCreate: model.ContactForCreate{
 				UUID:     pointer.To("1"),
-				Birthday: "2001-01-10",
+				Birthday: "2001/01/10",
 				Phone:    "+7 (915) 159-67-81",
 			},
-			prepare: func(_ *Mockstorage, validator *Mockvalidator) {
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
 				validator.EXPECT().
 					Validate(model.ContactForCreate{
 						UUID:     pointer.To("1"),
-						Birthday: "2001-01-10",
+						Birthday: "2001/01/10",
 						Phone:    "+7 (915) 159-67-81",
 					}).
 					Return(nil)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.Error(t, err)
 			},
 		},
@@ -81,7 +85,7 @@ func TestHandler_Update(t *testing.T) {
 				Birthday: "10.01.2001",
 				Phone:    "+7 (915) 1596781",
 			},
-			prepare: func(_ *Mockstorage, validator *Mockvalidator) {
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
 				validator.EXPECT().
 					Validate(model.ContactForCreate{
 						UUID:     pointer.To("1"),
@@ -90,7 +94,7 @@ func TestHandler_Update(t *testing.T) {
 					}).
 					Return(nil)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.Error(t, err)
 			},
 		},
@@ -100,7 +104,7 @@ func TestHandler_Update(t *testing.T) {
 				Birthday: "10.01.2001",
 				Phone:    "+7 (915) 159-67-81",
 			},
-			prepare: func(_ *Mockstorage, validator *Mockvalidator) {
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
 				validator.EXPECT().
 					Validate(model.ContactForCreate{
 						Birthday: "10.01.2001",
@@ -108,14 +112,14 @@ func TestHandler_Update(t *testing.T) {
 					}).
 					Return(nil)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.Error(t, err)
 			},
 		},
 		{
 			name:             "Failed to update",
 			contactForCreate: contact,
-			prepare: func(storage *Mockstorage, validator *Mockvalidator) {
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
 				validator.EXPECT().
 					Validate(contact).
 					Return(nil)
@@ -134,17 +138,102 @@ func TestHandler_Update(t *testing.T) {
 						Links: map[model.ContactLink]string{
 							model.ContactLinkVk: "vk.com",
 						},
+						Avatar:    "hash",
+						UpdatedAt: now,
 					}).
 					Return(assert.AnError)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name:             "Phone already belongs to another contact",
+			contactForCreate: contact,
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
+				validator.EXPECT().
+					Validate(contact).
+					Return(nil)
+
+				b, err := time.Parse("02.01.2006", contact.Birthday)
+				assert.NoError(t, err)
+
+				storage.EXPECT().
+					Update(model.Contact{
+						UUID:     "1",
+						Name:     "Виталий",
+						Surname:  "Ершов",
+						Birthday: b,
+						Phone:    model.NewPhoneFromInt64(79151596781),
+						Email:    "vaershov@avito.ru",
+						Links: map[model.ContactLink]string{
+							model.ContactLinkVk: "vk.com",
+						},
+						Avatar:    "hash",
+						UpdatedAt: now,
+					}).
+					Return(&model.UniqueViolationError{
+						Fields: map[model.Field]model.Message{
+							model.FieldPhone: model.NewMessage("msg", nil),
+						},
+						Blocked: true,
+					})
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+
+				expected := map[model.Field]model.Message{
+					model.FieldPhone: model.NewMessage("msg", nil),
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
+		{
+			name:             "Saved with unique warning",
+			contactForCreate: contact,
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
+				validator.EXPECT().
+					Validate(contact).
+					Return(nil)
+
+				b, err := time.Parse("02.01.2006", contact.Birthday)
+				assert.NoError(t, err)
+
+				storage.EXPECT().
+					Update(model.Contact{
+						UUID:     "1",
+						Name:     "Виталий",
+						Surname:  "Ершов",
+						Birthday: b,
+						Phone:    model.NewPhoneFromInt64(79151596781),
+						Email:    "vaershov@avito.ru",
+						Links: map[model.ContactLink]string{
+							model.ContactLinkVk: "vk.com",
+						},
+						Avatar:    "hash",
+						UpdatedAt: now,
+					}).
+					Return(&model.UniqueViolationError{
+						Fields: map[model.Field]model.Message{
+							model.FieldEmail: model.NewMessage("msg", nil),
+						},
+					})
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, model.ErrUniqueWarning)
+
+				expected := map[model.Field]model.Message{
+					model.FieldEmail: model.NewMessage("msg", nil),
+				}
+
+				assert.Equal(t, expected, actual)
+			},
+		},
 		{
 			name:             "Success",
 			contactForCreate: contact,
-			prepare: func(storage *Mockstorage, validator *Mockvalidator) {
+			prepare: func(storage *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
 				validator.EXPECT().
 					Validate(contact).
 					Return(nil)
@@ -163,14 +252,90 @@ func TestHandler_Update(t *testing.T) {
 						Links: map[model.ContactLink]string{
 							model.ContactLinkVk: "vk.com",
 						},
+						Avatar:    "hash",
+						UpdatedAt: now,
 					}).
 					Return(nil)
 			},
-			expectations: func(t assert.TestingT, actual map[model.Field]string, err error) {
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
 				assert.NoError(t, err)
 				assert.Empty(t, actual)
 			},
 		},
+		{
+			name: "Rejected contact does not create organization",
+			contactForCreate: model.ContactForCreate{
+				UUID:         pointer.To("1"),
+				Organization: "Авито",
+			},
+			prepare: func(_ *Mockstorage, _ *Mockorganizations, validator *Mockvalidator) {
+				validator.EXPECT().
+					Validate(gomock.Any()).
+					Return(map[model.Field]model.Message{
+						model.FieldName: model.NewMessage("msg", nil),
+					})
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+		{
+			name: "Contact is saved with organization",
+			contactForCreate: model.ContactForCreate{
+				UUID:         pointer.To("1"),
+				Name:         "Виталий",
+				Birthday:     "10.01.2001",
+				Phone:        "+7 (915) 159-67-81",
+				Organization: "Авито",
+			},
+			prepare: func(storage *Mockstorage, organizations *Mockorganizations, validator *Mockvalidator) {
+				validator.EXPECT().
+					Validate(gomock.Any()).
+					Return(nil)
+
+				organizations.EXPECT().
+					SaveWithOrganization(gomock.Any(), "Авито", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, save func(string) error) error {
+						return save("avito")
+					})
+
+				storage.EXPECT().
+					Update(model.Contact{
+						UUID:             "1",
+						Name:             "Виталий",
+						Birthday:         time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC),
+						Phone:            model.NewPhoneFromInt64(79151596781),
+						OrganizationUUID: "avito",
+						UpdatedAt:        now,
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Failed to save organization",
+			contactForCreate: model.ContactForCreate{
+				UUID:         pointer.To("1"),
+				Name:         "Виталий",
+				Birthday:     "10.01.2001",
+				Phone:        "+7 (915) 159-67-81",
+				Organization: "Авито",
+			},
+			prepare: func(_ *Mockstorage, organizations *Mockorganizations, validator *Mockvalidator) {
+				validator.EXPECT().
+					Validate(gomock.Any()).
+					Return(nil)
+
+				organizations.EXPECT().
+					SaveWithOrganization(gomock.Any(), "Авито", gomock.Any()).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual map[model.Field]model.Message, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -181,13 +346,28 @@ func TestHandler_Update(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockStorage := NewMockstorage(ctrl)
+			mockOrganizations := NewMockorganizations(ctrl)
 			mockValidator := NewMockvalidator(ctrl)
+			mockClock := NewMockclock(ctrl)
+
+			mockClock.EXPECT().
+				Now().
+				Return(now).
+				AnyTimes()
 
 			if tc.prepare != nil {
-				tc.prepare(mockStorage, mockValidator)
+				tc.prepare(mockStorage, mockOrganizations, mockValidator)
 			}
 
-			instance := NewHandler(mockStorage, mockValidator)
+			// Контакт без организации сохраняется как есть
+			mockOrganizations.EXPECT().
+				SaveWithOrganization(gomock.Any(), "", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, save func(string) error) error {
+					return save("")
+				}).
+				AnyTimes()
+
+			instance := NewHandler(mockStorage, mockOrganizations, mockValidator, date.NewFormatter("ru"), mockClock)
 
 			out, err := instance.Update(context.Background(), tc.contactForCreate)
 
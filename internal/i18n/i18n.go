@@ -0,0 +1,129 @@
+package i18n
+
+import (
+	"embed"
+	"fmt"
+
+	goi18n "github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+
+	"contacts/internal/model"
+)
+
+// DefaultLocale – язык, на который откатываемся, если для выбранного нет перевода
+const DefaultLocale = "en"
+
+//go:embed translations/*.json
+var translations embed.FS
+
+// Catalog – каталог сообщений для пользователя на выбранном языке.
+//
+// Сообщения ищутся по идентификатору, параметры подставляются в шаблон.
+// Если сообщения нет ни в одном языке, возвращается его идентификатор.
+type Catalog struct {
+	localizer *goi18n.Localizer
+	locale    language.Tag
+	supported []language.Tag
+}
+
+// New – каталог для первого поддерживаемого языка из списка, например "ru-RU".
+//
+// Пустые и нераспознанные языки пропускаются, при отсутствии подходящего используется DefaultLocale.
+func New(locales ...string) (*Catalog, error) {
+	bundle := goi18n.NewBundle(language.MustParse(DefaultLocale))
+
+	files, err := translations.ReadDir("translations")
+	if err != nil {
+		return nil, fmt.Errorf("read translations: %w", err)
+	}
+
+	for _, file := range files {
+		_, err = bundle.LoadMessageFileFS(translations, "translations/"+file.Name())
+		if err != nil {
+			return nil, fmt.Errorf("load %s: %w", file.Name(), err)
+		}
+	}
+
+	preferred := make([]language.Tag, 0, len(locales))
+	for _, locale := range locales {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			continue
+		}
+		preferred = append(preferred, tag)
+	}
+
+	// Точный язык из бандла, например "ru" для "ru-RU"
+	supported := bundle.LanguageTags()
+	_, index, confidence := language.NewMatcher(supported).Match(preferred...)
+
+	locale := language.MustParse(DefaultLocale)
+	if confidence != language.No {
+		locale = supported[index]
+	}
+
+	return &Catalog{
+		localizer: goi18n.NewLocalizer(bundle, locale.String(), DefaultLocale),
+		locale:    locale,
+		supported: supported,
+	}, nil
+}
+
+// Locale – выбранный язык, например "ru"
+func (c *Catalog) Locale() string {
+	return c.locale.String()
+}
+
+// Locales – языки, для которых есть перевод, например "en" и "ru"
+func (c *Catalog) Locales() []string {
+	locales := make([]string, 0, len(c.supported))
+	for _, tag := range c.supported {
+		locales = append(locales, tag.String())
+	}
+
+	return locales
+}
+
+// T – сообщение без параметров
+func (c *Catalog) T(id string) string {
+	return c.Format(id, nil)
+}
+
+// Format – сообщение с подстановкой параметров
+func (c *Catalog) Format(id string, params map[string]any) string {
+	return c.localize(&goi18n.LocalizeConfig{
+		MessageID:    id,
+		TemplateData: params,
+	})
+}
+
+// Plural – сообщение, форма которого зависит от count.
+//
+// count доступен в шаблоне как {{.Count}}.
+func (c *Catalog) Plural(id string, count int, params map[string]any) string {
+	data := make(map[string]any, len(params)+1)
+	for key, value := range params {
+		data[key] = value
+	}
+	data["Count"] = count
+
+	return c.localize(&goi18n.LocalizeConfig{
+		MessageID:    id,
+		TemplateData: data,
+		PluralCount:  count,
+	})
+}
+
+// Message – текст сообщения, которое вернула бизнес-логика
+func (c *Catalog) Message(message model.Message) string {
+	return c.Format(message.ID, message.Params)
+}
+
+func (c *Catalog) localize(config *goi18n.LocalizeConfig) string {
+	text, err := c.localizer.Localize(config)
+	if err != nil {
+		return config.MessageID
+	}
+
+	return text
+}
@@ -0,0 +1,192 @@
+package i18n_test
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "contacts/internal/i18n"
+	"contacts/internal/model"
+)
+
+func TestNew_Locale(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		locales  []string
+		expected string
+	}{
+		{
+			name:     "Russian with region",
+			locales:  []string{"ru-RU"},
+			expected: "ru",
+		},
+		{
+			name:     "English",
+			locales:  []string{"en-US"},
+			expected: "en",
+		},
+		{
+			name:     "Unsupported falls back to default",
+			locales:  []string{"de-DE"},
+			expected: DefaultLocale,
+		},
+		{
+			name:     "Empty and broken locales are skipped",
+			locales:  []string{"", "???", "ru"},
+			expected: "ru",
+		},
+		{
+			name:     "No locales",
+			expected: DefaultLocale,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			catalog, err := New(tc.locales...)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, catalog.Locale())
+		})
+	}
+}
+
+func TestCatalog_Locales(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := New("ru")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"en", "ru"}, catalog.Locales())
+}
+
+func TestCatalog_Message(t *testing.T) {
+	t.Parallel()
+
+	message := model.NewMessage(model.MsgUniquePhone, map[string]any{
+		"Surname": "Ершов",
+		"Name":    "Виталий",
+	})
+
+	tests := []struct {
+		name     string
+		locale   string
+		message  model.Message
+		expected string
+	}{
+		{
+			name:     "Russian",
+			locale:   "ru",
+			message:  message,
+			expected: "Телефон уже указан у контакта Ершов Виталий",
+		},
+		{
+			name:     "English",
+			locale:   "en",
+			message:  message,
+			expected: "Phone is already used by contact Ершов Виталий",
+		},
+		{
+			name:     "Unknown message returns its ID",
+			locale:   "ru",
+			message:  model.NewMessage("unknown.message", nil),
+			expected: "unknown.message",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			catalog, err := New(tc.locale)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, catalog.Message(tc.message))
+		})
+	}
+}
+
+func TestCatalog_Plural(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		locale   string
+		count    int
+		expected string
+	}{
+		{name: "ru one", locale: "ru", count: 21, expected: "через 21 день"},
+		{name: "ru few", locale: "ru", count: 3, expected: "через 3 дня"},
+		{name: "ru many", locale: "ru", count: 11, expected: "через 11 дней"},
+		{name: "en one", locale: "en", count: 1, expected: "in 1 day"},
+		{name: "en other", locale: "en", count: 5, expected: "in 5 days"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			catalog, err := New(tc.locale)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, catalog.Plural("birthday.in", tc.count, nil))
+		})
+	}
+}
+
+// Все сообщения должны быть переведены на все языки
+func TestTranslations_SameKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := func(path string) []string {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		var messages map[string]any
+		require.NoError(t, json.Unmarshal(data, &messages))
+
+		result := make([]string, 0, len(messages))
+		for key := range messages {
+			result = append(result, key)
+		}
+		sort.Strings(result)
+
+		return result
+	}
+
+	en := keys("translations/active.en.json")
+	ru := keys("translations/active.ru.json")
+
+	assert.Equal(t, en, ru)
+
+	// Сообщения бизнес-логики тоже есть в каталоге
+	for _, id := range []string{
+		model.MsgValidationName,
+		model.MsgValidationSurname,
+		model.MsgValidationBirthdayFormat,
+		model.MsgValidationBirthdayFuture,
+		model.MsgValidationBirthdayMin,
+		model.MsgValidationPhone,
+		model.MsgValidationEmail,
+		model.MsgValidationLink,
+		model.MsgUniquePhone,
+		model.MsgUniqueEmail,
+		model.MsgUniqueField,
+	} {
+		assert.Contains(t, en, id)
+	}
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// UpcomingBirthday – ближайший день рождения контакта
+type UpcomingBirthday struct {
+	Contact  Contact
+	Date     time.Time // Дата ближайшего дня рождения
+	Age      int       // Сколько лет исполнится, 0 – год рождения неизвестен
+	DaysLeft int       // Сколько дней осталось, 0 – сегодня
+}
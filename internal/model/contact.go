@@ -14,6 +14,24 @@ type Contact struct {
 	Phone    Phone
 	Email    string
 	Links    map[ContactLink]string
+	Avatar   string   // Хэш миниатюры в хранилище изображений
+	Tags     []string // Метки в порядке добавления, без повторов
+	Favorite bool     // Закреплен в начале списка
+	Notes    string   // Заметки в Markdown
+
+	// Место работы, пустой OrganizationUUID – организация не указана
+	OrganizationUUID string
+	Department       string
+	Title            string // Должность
+
+	Relations []Relation // Связи, которые хранятся у этого контакта
+
+	Interactions []Interaction // Журнал общения, последние записи – первые
+	KeepInTouch  int           // Как часто хочется общаться, в днях, 0 – не напоминать
+
+	CreatedAt time.Time // Когда контакт появился в книге
+	UpdatedAt time.Time // Когда контакт последний раз сохраняли
+	Source    Source    // Откуда контакт появился
 }
 
 type ContactForCreate struct {
@@ -24,4 +42,13 @@ type ContactForCreate struct {
 	Phone    string
 	Email    string
 	Links    map[ContactLink]string
+	Avatar   string
+	Tags     []string
+	Favorite bool
+	Notes    string
+	Source   Source // Пустой – контакт создан вручную
+
+	Organization string // Название организации, новая создается вместе с контактом, пустое – не указана
+	Department   string
+	Title        string
 }
@@ -0,0 +1,23 @@
+package model
+
+// DuplicatePair – пара контактов, похожих на одного и того же человека
+type DuplicatePair struct {
+	First   Contact
+	Second  Contact
+	Score   float64 // Степень похожести от 0 до 1
+	Matches []Field // Совпавшие поля
+}
+
+type MergeSide string
+
+const (
+	MergeSideTarget MergeSide = "target"
+	MergeSideSource MergeSide = "source"
+)
+
+// MergeRequest – запрос на объединение двух контактов
+type MergeRequest struct {
+	TargetUUID string              // Контакт, который останется после объединения
+	SourceUUID string              // Контакт, который будет удален после объединения
+	Choices    map[Field]MergeSide // Из какого контакта брать значение поля при конфликте
+}
@@ -1,9 +1,37 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
 
 var (
 	ErrNotFound      = errors.New("not found")
 	ErrAlreadyExists = errors.New("already exists")
 	ErrValidation    = errors.New("validation error")
+	ErrNotUnique     = errors.New("not unique")
+	ErrUniqueWarning = errors.New("unique warning")
+	ErrRelation      = errors.New("invalid relation")
 )
+
+// UniqueViolationError – значения полей уже принадлежат другому контакту
+type UniqueViolationError struct {
+	Fields  map[Field]Message // Сообщение для каждого конфликтующего поля
+	Blocked bool              // true – контакт не сохранен, false – сохранен с предупреждением
+}
+
+func (e *UniqueViolationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, string(field))
+	}
+	sort.Strings(fields)
+
+	return fmt.Sprintf("%s: %s", ErrNotUnique, strings.Join(fields, ", "))
+}
+
+func (e *UniqueViolationError) Unwrap() error {
+	return ErrNotUnique
+}
@@ -8,4 +8,10 @@ const (
 	FieldBirthday Field = "birthday"
 	FieldPhone    Field = "phone"
 	FieldEmail    Field = "email"
+	FieldAvatar   Field = "avatar"
+	FieldNotes    Field = "notes"
+
+	FieldOrganization Field = "organization"
+	FieldDepartment   Field = "department"
+	FieldTitle        Field = "title"
 )
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// InteractionType – как общались с контактом
+type InteractionType string
+
+const (
+	InteractionCall    InteractionType = "call"    // Звонок
+	InteractionMeeting InteractionType = "meeting" // Встреча
+	InteractionMessage InteractionType = "message" // Переписка
+)
+
+// InteractionTypes – типы общения в порядке показа
+var InteractionTypes = []InteractionType{
+	InteractionCall,
+	InteractionMeeting,
+	InteractionMessage,
+}
+
+// Valid – тип общения известен
+func (t InteractionType) Valid() bool {
+	return slices.Contains(InteractionTypes, t)
+}
+
+// Interaction – запись в журнале общения с контактом
+type Interaction struct {
+	UUID string
+	Type InteractionType
+	Date time.Time // День, когда общались, без времени
+	Note string
+}
+
+type InteractionForCreate struct {
+	Type InteractionType
+	Date string // Пустая – сегодня
+	Note string
+}
+
+// LastContacted – когда последний раз общались с контактом, нулевая дата – ни разу
+func (c Contact) LastContacted() time.Time {
+	var last time.Time
+	for _, interaction := range c.Interactions {
+		if interaction.Date.After(last) {
+			last = interaction.Date
+		}
+	}
+
+	return last
+}
+
+// ReconnectDue – контакт, с которым пора связаться
+type ReconnectDue struct {
+	Contact       Contact
+	LastContacted time.Time // Нулевая – ни разу не общались
+	DaysOverdue   int       // На сколько дней просрочена желаемая частота, 0 – срок сегодня
+}
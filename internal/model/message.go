@@ -0,0 +1,33 @@
+package model
+
+// Идентификаторы сообщений для пользователя.
+//
+// Тексты лежат в каталоге переводов (internal/i18n), здесь только ключи.
+const (
+	MsgValidationName           = "validation.name"
+	MsgValidationSurname        = "validation.surname"
+	MsgValidationBirthdayFormat = "validation.birthday.format"
+	MsgValidationBirthdayFuture = "validation.birthday.future"
+	MsgValidationBirthdayMin    = "validation.birthday.min"
+	MsgValidationPhone          = "validation.phone"
+	MsgValidationEmail          = "validation.email"
+	MsgValidationLink           = "validation.link"
+	MsgUniquePhone              = "unique.phone"
+	MsgUniqueEmail              = "unique.email"
+	MsgUniqueField              = "unique.field"
+)
+
+// Message – сообщение для пользователя: ключ в каталоге переводов и параметры для подстановки.
+//
+// Готовый текст получает UI, бизнес-логика про язык ничего не знает.
+type Message struct {
+	ID     string
+	Params map[string]any
+}
+
+func NewMessage(id string, params map[string]any) Message {
+	return Message{
+		ID:     id,
+		Params: params,
+	}
+}
@@ -0,0 +1,9 @@
+package model
+
+// Organization – организация, в которой работают контакты.
+//
+// Контакты ссылаются на нее по UUID, поэтому переименование сразу видно у всех сотрудников.
+type Organization struct {
+	UUID string
+	Name string
+}
@@ -0,0 +1,71 @@
+package model
+
+// RelationType – кем связанный контакт приходится контакту
+type RelationType string
+
+const (
+	RelationSpouse       RelationType = "spouse"        // Супруг или супруга
+	RelationChild        RelationType = "child"         // Ребенок
+	RelationParent       RelationType = "parent"        // Родитель, обратная к RelationChild
+	RelationColleague    RelationType = "colleague"     // Коллега
+	RelationManager      RelationType = "manager"       // Руководитель
+	RelationReport       RelationType = "report"        // Подчиненный, обратная к RelationManager
+	RelationIntroducedBy RelationType = "introduced_by" // Кто познакомил
+	RelationIntroduced   RelationType = "introduced"    // С кем познакомил, обратная к RelationIntroducedBy
+)
+
+// RelationTypes – связи, которые можно добавить вручную, в порядке показа.
+//
+// Обратные связи появляются у второго контакта сами.
+var RelationTypes = []RelationType{
+	RelationSpouse,
+	RelationChild,
+	RelationColleague,
+	RelationManager,
+	RelationIntroducedBy,
+}
+
+var inverseRelations = map[RelationType]RelationType{
+	RelationSpouse:       RelationSpouse,
+	RelationChild:        RelationParent,
+	RelationParent:       RelationChild,
+	RelationColleague:    RelationColleague,
+	RelationManager:      RelationReport,
+	RelationReport:       RelationManager,
+	RelationIntroducedBy: RelationIntroduced,
+	RelationIntroduced:   RelationIntroducedBy,
+}
+
+// Inverse – кем контакт приходится связанному контакту.
+//
+// Для неизвестного типа возвращается сам тип.
+func (t RelationType) Inverse() RelationType {
+	inverse, ok := inverseRelations[t]
+	if !ok {
+		return t
+	}
+
+	return inverse
+}
+
+// Valid – тип связи известен
+func (t RelationType) Valid() bool {
+	_, ok := inverseRelations[t]
+
+	return ok
+}
+
+// Relation – связь контакта с другим контактом.
+//
+// Хранится у одного из двух контактов, у второго показывается обратная связь.
+type Relation struct {
+	Type RelationType
+	UUID string // Связанный контакт
+}
+
+// RelatedContact – связанный контакт с точки зрения выбранного контакта
+type RelatedContact struct {
+	Type     RelationType // Кем связанный контакт приходится выбранному
+	Contact  Contact
+	Incoming bool // Связь хранится у связанного контакта
+}
@@ -0,0 +1,13 @@
+package model
+
+// Reminder – напоминание о дне рождения, которое пора отправить
+type Reminder struct {
+	Key      string // Уникален для контакта, даты и дня напоминания, нужен чтобы не отправлять дважды
+	Birthday UpcomingBirthday
+}
+
+// ReconnectReminder – напоминание связаться с контактом
+type ReconnectReminder struct {
+	Key string // Уникален для контакта и последнего общения, нужен чтобы не отправлять дважды
+	Due ReconnectDue
+}
@@ -2,4 +2,4 @@ package model
 
 type SearchRequest struct {
 	Query string // Поисковый запрос
-}
\ No newline at end of file
+}
@@ -0,0 +1,12 @@
+package model
+
+// SortKey – поле, по которому упорядочен список контактов
+type SortKey string
+
+const (
+	SortKeySurname  SortKey = "surname"
+	SortKeyName     SortKey = "name"
+	SortKeyBirthday SortKey = "birthday" // По дню в году, без даты – в конце
+	SortKeyCreated  SortKey = "created"  // Сначала добавленные последними
+	SortKeyUpdated  SortKey = "updated"  // Сначала измененные последними
+)
@@ -0,0 +1,21 @@
+package model
+
+// Source – откуда контакт появился в книге
+type Source string
+
+const (
+	SourceManual      Source = "manual"       // Создан в приложении
+	SourceImportVCard Source = "import:vcard" // Импортирован из vCard
+	SourceImportCSV   Source = "import:csv"   // Импортирован из CSV
+	SourceAPI         Source = "api"          // Создан через API
+	SourceSync        Source = "sync"         // Получен при синхронизации
+)
+
+// Sources – все источники в порядке показа в фильтре
+var Sources = []Source{
+	SourceManual,
+	SourceImportVCard,
+	SourceImportCSV,
+	SourceAPI,
+	SourceSync,
+}
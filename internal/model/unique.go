@@ -0,0 +1,8 @@
+package model
+
+type UniqueMode string
+
+const (
+	UniqueModeBlock UniqueMode = "block" // Запретить сохранение
+	UniqueModeWarn  UniqueMode = "warn"  // Сохранить, но предупредить
+)
@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// Job – периодическая задача, например отправка напоминаний
+type Job interface {
+	Run(ctx context.Context, now time.Time)
+}
+
+type clock interface {
+	Now() time.Time
+}
+
+// Scheduler – запускает задачи сразу после старта и затем с заданным интервалом
+type Scheduler struct {
+	clock    clock
+	interval time.Duration
+	jobs     []Job
+}
+
+func New(c clock, interval time.Duration, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		clock:    c,
+		interval: interval,
+		jobs:     jobs,
+	}
+}
+
+// Run – блокирует выполнение до отмены контекста
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		now := s.clock.Now()
+		for _, job := range s.jobs {
+			job.Run(ctx, now)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
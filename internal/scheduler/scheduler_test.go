@@ -0,0 +1,59 @@
+package scheduler_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "contacts/internal/scheduler"
+	"contacts/util/clock"
+)
+
+// countingJob – задача, которая считает свои запуски и отменяет контекст после limit запусков
+type countingJob struct {
+	mu     sync.Mutex
+	runs   int
+	limit  int
+	cancel context.CancelFunc
+}
+
+func (j *countingJob) Run(_ context.Context, _ time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.runs++
+	if j.runs == j.limit {
+		j.cancel()
+	}
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := &countingJob{limit: 3, cancel: cancel}
+	second := &countingJob{cancel: func() {}}
+
+	instance := New(clock.New(), time.Millisecond, first, second)
+
+	done := make(chan struct{})
+	go func() {
+		instance.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler didn't stop after context cancel")
+	}
+
+	// Задачи запускаются по очереди, поэтому вторая успевает отработать столько же раз
+	assert.Equal(t, 3, first.runs)
+	assert.Equal(t, 3, second.runs)
+}
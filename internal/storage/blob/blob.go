@@ -0,0 +1,71 @@
+package blob
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"contacts/internal/model"
+)
+
+const extension = ".png"
+
+// Blob – хранилище бинарных данных, адресуемых по содержимому.
+//
+// Имя файла – sha256 от его содержимого, поэтому одинаковые изображения хранятся один раз.
+type Blob struct {
+	dir string
+}
+
+func New(dir string) *Blob {
+	return &Blob{
+		dir: dir,
+	}
+}
+
+// Save – сохраняет данные и возвращает их хэш
+func (b *Blob) Save(data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	err := os.MkdirAll(b.dir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("mkdir: %w", err)
+	}
+
+	path := b.path(hash)
+
+	// Такой блоб уже сохранен
+	_, err = os.Stat(path)
+	if err == nil {
+		return hash, nil
+	}
+
+	err = os.WriteFile(path, data, 0644)
+	if err != nil {
+		return "", fmt.Errorf("write file: %w", err)
+	}
+
+	return hash, nil
+}
+
+// Read – получить данные по хэшу
+func (b *Blob) Read(hash string) ([]byte, error) {
+	data, err := os.ReadFile(b.path(hash))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, model.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return data, nil
+}
+
+func (b *Blob) path(hash string) string {
+	return filepath.Join(b.dir, filepath.Base(hash)+extension)
+}
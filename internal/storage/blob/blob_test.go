@@ -0,0 +1,55 @@
+package blob_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"contacts/internal/model"
+	. "contacts/internal/storage/blob"
+)
+
+func TestBlob_Save(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "avatars")
+
+	b := New(dir)
+
+	hash, err := b.Save([]byte("image"))
+	require.NoError(t, err, "Неожиданная ошибка при сохранении")
+
+	// Хэш sha256 от строки "image"
+	assert.Equal(t, "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d", hash)
+
+	data, err := os.ReadFile(filepath.Join(dir, hash+".png"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("image"), data)
+
+	// Повторное сохранение тех же данных возвращает тот же хэш
+	again, err := b.Save([]byte("image"))
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+}
+
+func TestBlob_Read(t *testing.T) {
+	b := New(t.TempDir())
+
+	hash, err := b.Save([]byte("image"))
+	require.NoError(t, err)
+
+	data, err := b.Read(hash)
+	require.NoError(t, err, "Неожиданная ошибка при чтении")
+	assert.Equal(t, []byte("image"), data)
+}
+
+// Тест для чтения несуществующего блоба
+func TestBlob_Read_NotFound(t *testing.T) {
+	b := New(t.TempDir())
+
+	data, err := b.Read("unknown")
+
+	assert.Nil(t, data)
+	assert.ErrorIs(t, err, model.ErrNotFound)
+}
@@ -2,28 +2,52 @@ package database
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
-
-	"contacts/internal/storage"
+	"path/filepath"
 )
 
-type Database struct {
+// Database – JSON-файл с записями T по идентификатору.
+//
+// Одна реализация служит базой контактов и базой организаций.
+type Database[T any] struct {
 	path string
 }
 
-func New(path string) *Database {
-	return &Database{
+func New[T any](path string) *Database[T] {
+	return &Database[T]{
 		path: path,
 	}
 }
 
-func (d *Database) Save(contacts map[string]storage.Contact) error {
-	b, err := json.Marshal(contacts)
+// Init – создает пустую базу, если ее еще нет.
+//
+// Нужна при первом запуске, когда каталог данных пользователя пуст.
+func (d *Database[T]) Init() error {
+	_, err := os.Stat(d.path)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("stat: %w", err)
+	}
+
+	return d.Save(map[string]T{})
+}
+
+func (d *Database[T]) Save(records map[string]T) error {
+	b, err := json.Marshal(records)
 	if err != nil {
 		return fmt.Errorf("marshall: %w", err)
 	}
 
+	// База может лежать в еще не созданном каталоге данных пользователя
+	err = os.MkdirAll(filepath.Dir(d.path), 0755)
+	if err != nil {
+		return fmt.Errorf("mkdir: %w", err)
+	}
+
 	err = os.WriteFile(d.path, b, 0644)
 	if err != nil {
 		return fmt.Errorf("write file: %w", err)
@@ -32,13 +56,13 @@ func (d *Database) Save(contacts map[string]storage.Contact) error {
 	return nil
 }
 
-func (d *Database) Read() (map[string]storage.Contact, error) {
+func (d *Database[T]) Read() (map[string]T, error) {
 	b, err := os.ReadFile(d.path)
 	if err != nil {
 		return nil, fmt.Errorf("read file: %w", err)
 	}
 
-	var dto map[string]storage.Contact
+	var dto map[string]T
 	err = json.Unmarshal(b, &dto)
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal: %w", err)
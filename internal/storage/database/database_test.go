@@ -3,6 +3,7 @@ package database_test
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,7 @@ import (
 
 	"contacts/internal/storage"
 	. "contacts/internal/storage/database"
+	"contacts/internal/storage/organization"
 )
 
 // Тест для метода Save
@@ -19,7 +21,7 @@ func TestDatabase_Save(t *testing.T) {
 	require.NoError(t, err)
 	defer os.Remove(tempFile.Name()) // Удаляем файл после теста
 
-	db := New(tempFile.Name())
+	db := New[storage.Contact](tempFile.Name())
 
 	contacts := map[string]storage.Contact{
 		"john": {Name: "John Doe", Phone: 79151596781},
@@ -61,7 +63,7 @@ func TestDatabase_Read(t *testing.T) {
 	require.NoError(t, err)
 
 	// Создаем экземпляр базы данных
-	db := New(tempFile.Name())
+	db := New[storage.Contact](tempFile.Name())
 
 	// Читаем контакты
 	readContacts, err := db.Read()
@@ -73,7 +75,7 @@ func TestDatabase_Read(t *testing.T) {
 
 // Тест для обработки ошибки чтения файла
 func TestDatabase_Read_FileError(t *testing.T) {
-	db := New("non_existent_file.json")
+	db := New[storage.Contact]("non_existent_file.json")
 
 	// Пытаемся читать из несуществующего файла
 	contacts, err := db.Read()
@@ -94,7 +96,7 @@ func TestDatabase_Read_UnmarshalError(t *testing.T) {
 	require.NoError(t, err)
 
 	// Создаем экземпляр базы данных
-	db := New(tempFile.Name())
+	db := New[storage.Contact](tempFile.Name())
 
 	// Пытаемся читать из файла с некорректным JSON
 	contacts, err := db.Read()
@@ -102,3 +104,43 @@ func TestDatabase_Read_UnmarshalError(t *testing.T) {
 	assert.Nil(t, contacts, "Контакты должны быть nil при ошибке десериализации")
 	assert.Error(t, err, "Ошибка десериализации не должна быть nil")
 }
+
+func TestDatabase_Init(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "contacts", "database.json")
+	db := New[storage.Contact](path)
+
+	err := db.Init()
+	require.NoError(t, err, "Каталог и пустая база должны создаваться при первом запуске")
+
+	readContacts, err := db.Read()
+	require.NoError(t, err)
+	assert.Empty(t, readContacts)
+
+	// Существующая база не перезаписывается
+	contacts := map[string]storage.Contact{
+		"john": {Name: "John Doe", Phone: 79151596781},
+	}
+	require.NoError(t, db.Save(contacts))
+	require.NoError(t, db.Init())
+
+	readContacts, err = db.Read()
+	require.NoError(t, err)
+	assert.Equal(t, contacts, readContacts)
+}
+
+func TestDatabase_Organizations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "contacts", "organizations.json")
+	db := New[organization.Organization](path)
+
+	require.NoError(t, db.Init())
+
+	// Та же реализация хранит записи другого типа
+	organizations := map[string]organization.Organization{
+		"1": {UUID: "1", Name: "Авито"},
+	}
+	require.NoError(t, db.Save(organizations))
+
+	readOrganizations, err := db.Read()
+	require.NoError(t, err)
+	assert.Equal(t, organizations, readOrganizations)
+}
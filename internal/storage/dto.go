@@ -14,6 +14,38 @@ type Contact struct {
 	Phone    int64             `json:"phone"`
 	Email    string            `json:"email"`
 	Links    map[string]string `json:"links"`
+	Avatar   string            `json:"avatar"`
+	Tags     []string          `json:"tags,omitempty"`
+	Favorite bool              `json:"favorite,omitempty"`
+	Notes    string            `json:"notes,omitempty"`
+
+	Organization string `json:"organization,omitempty"`
+	Department   string `json:"department,omitempty"`
+	Title        string `json:"title,omitempty"`
+
+	Relations []Relation `json:"relations,omitempty"`
+
+	Interactions []Interaction `json:"interactions,omitempty"`
+	KeepInTouch  int           `json:"keep_in_touch,omitempty"`
+
+	// Метаданные, у записей из старых версий их нет до миграции
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Source    string    `json:"source,omitempty"`
+}
+
+// Interaction – запись в журнале общения
+type Interaction struct {
+	UUID string    `json:"uuid"`
+	Type string    `json:"type"`
+	Date time.Time `json:"date"`
+	Note string    `json:"note,omitempty"`
+}
+
+// Relation – связь с другим контактом
+type Relation struct {
+	Type string `json:"type"`
+	UUID string `json:"uuid"`
 }
 
 func dtoToModel(contactDto Contact) model.Contact {
@@ -30,6 +62,23 @@ func dtoToModel(contactDto Contact) model.Contact {
 		Phone:    model.NewPhoneFromInt64(contactDto.Phone),
 		Email:    contactDto.Email,
 		Links:    links,
+		Avatar:   contactDto.Avatar,
+		Tags:     contactDto.Tags,
+		Favorite: contactDto.Favorite,
+		Notes:    contactDto.Notes,
+
+		OrganizationUUID: contactDto.Organization,
+		Department:       contactDto.Department,
+		Title:            contactDto.Title,
+
+		Relations: relationsToModel(contactDto.Relations),
+
+		Interactions: interactionsToModel(contactDto.Interactions),
+		KeepInTouch:  contactDto.KeepInTouch,
+
+		CreatedAt: contactDto.CreatedAt,
+		UpdatedAt: contactDto.UpdatedAt,
+		Source:    model.Source(contactDto.Source),
 	}
 }
 
@@ -47,5 +96,90 @@ func modelToDto(contact model.Contact) Contact {
 		Phone:    contact.Phone.Number(),
 		Email:    contact.Email,
 		Links:    linksDto,
+		Avatar:   contact.Avatar,
+		Tags:     contact.Tags,
+		Favorite: contact.Favorite,
+		Notes:    contact.Notes,
+
+		Organization: contact.OrganizationUUID,
+		Department:   contact.Department,
+		Title:        contact.Title,
+
+		Relations: relationsToDto(contact.Relations),
+
+		Interactions: interactionsToDto(contact.Interactions),
+		KeepInTouch:  contact.KeepInTouch,
+
+		CreatedAt: contact.CreatedAt,
+		UpdatedAt: contact.UpdatedAt,
+		Source:    string(contact.Source),
+	}
+}
+
+func relationsToModel(relationsDto []Relation) []model.Relation {
+	if len(relationsDto) == 0 {
+		return nil
+	}
+
+	relations := make([]model.Relation, 0, len(relationsDto))
+	for _, relationDto := range relationsDto {
+		relations = append(relations, model.Relation{
+			Type: model.RelationType(relationDto.Type),
+			UUID: relationDto.UUID,
+		})
+	}
+
+	return relations
+}
+
+func relationsToDto(relations []model.Relation) []Relation {
+	if len(relations) == 0 {
+		return nil
+	}
+
+	relationsDto := make([]Relation, 0, len(relations))
+	for _, relation := range relations {
+		relationsDto = append(relationsDto, Relation{
+			Type: string(relation.Type),
+			UUID: relation.UUID,
+		})
+	}
+
+	return relationsDto
+}
+
+func interactionsToModel(interactionsDto []Interaction) []model.Interaction {
+	if len(interactionsDto) == 0 {
+		return nil
+	}
+
+	interactions := make([]model.Interaction, 0, len(interactionsDto))
+	for _, interactionDto := range interactionsDto {
+		interactions = append(interactions, model.Interaction{
+			UUID: interactionDto.UUID,
+			Type: model.InteractionType(interactionDto.Type),
+			Date: interactionDto.Date,
+			Note: interactionDto.Note,
+		})
+	}
+
+	return interactions
+}
+
+func interactionsToDto(interactions []model.Interaction) []Interaction {
+	if len(interactions) == 0 {
+		return nil
 	}
+
+	interactionsDto := make([]Interaction, 0, len(interactions))
+	for _, interaction := range interactions {
+		interactionsDto = append(interactionsDto, Interaction{
+			UUID: interaction.UUID,
+			Type: string(interaction.Type),
+			Date: interaction.Date,
+			Note: interaction.Note,
+		})
+	}
+
+	return interactionsDto
 }
@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"time"
+
+	"contacts/internal/model"
+)
+
+// SetInteractions – заменить журнал общения с контактом, updatedAt – новая дата изменения контакта
+func (s *Storage) SetInteractions(uuid string, interactions []model.Interaction, updatedAt time.Time) error {
+	return s.modify(uuid, updatedAt, func(contactDto *Contact, _ map[string]Contact) error {
+		contactDto.Interactions = interactionsToDto(interactions)
+		return nil
+	})
+}
+
+// SetKeepInTouch – задать, как часто хочется общаться с контактом, в днях, 0 – не напоминать
+func (s *Storage) SetKeepInTouch(uuid string, days int, updatedAt time.Time) error {
+	return s.modify(uuid, updatedAt, func(contactDto *Contact, _ map[string]Contact) error {
+		contactDto.KeepInTouch = days
+		return nil
+	})
+}
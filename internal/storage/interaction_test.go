@@ -0,0 +1,196 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"contacts/internal/model"
+	. "contacts/internal/storage"
+)
+
+func TestStorage_SetInteractions(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		uuid         string
+		interactions []model.Interaction
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name: "Failed to read from database",
+			uuid: "1",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Contact not found",
+			uuid: "1",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name: "Success",
+			uuid: "1",
+			interactions: []model.Interaction{
+				{UUID: "i1", Type: model.InteractionCall, Date: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), Note: "Обсудили отпуск"},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID:        "1",
+							Name:        "Иван",
+							KeepInTouch: 30,
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:        "1",
+							Name:        "Иван",
+							KeepInTouch: 30,
+							Interactions: []Interaction{
+								{UUID: "i1", Type: "call", Date: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), Note: "Обсудили отпуск"},
+							},
+							UpdatedAt: updatedAt,
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			err := instance.SetInteractions(tc.uuid, tc.interactions, updatedAt)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestStorage_SetKeepInTouch(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		uuid         string
+		days         int
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name: "Contact not found",
+			uuid: "1",
+			days: 30,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name: "Failed to save",
+			uuid: "1",
+			days: 30,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {UUID: "1"},
+					}, nil)
+
+				db.EXPECT().
+					Save(gomock.Any()).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Success",
+			uuid: "1",
+			days: 14,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {UUID: "1", KeepInTouch: 30},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {UUID: "1", KeepInTouch: 14, UpdatedAt: updatedAt},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			err := instance.SetKeepInTouch(tc.uuid, tc.days, updatedAt)
+
+			tc.expectations(t, err)
+		})
+	}
+}
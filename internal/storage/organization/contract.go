@@ -0,0 +1,7 @@
+//go:generate mockgen -source ${GOFILE} -destination mocks_test.go -package ${GOPACKAGE}_test
+package organization
+
+type database interface {
+	Read() (map[string]Organization, error)
+	Save(organizations map[string]Organization) error
+}
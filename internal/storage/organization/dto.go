@@ -0,0 +1,22 @@
+package organization
+
+import "contacts/internal/model"
+
+type Organization struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+func dtoToModel(organizationDto Organization) model.Organization {
+	return model.Organization{
+		UUID: organizationDto.UUID,
+		Name: organizationDto.Name,
+	}
+}
+
+func modelToDto(organization model.Organization) Organization {
+	return Organization{
+		UUID: organization.UUID,
+		Name: organization.Name,
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source contract.go -destination mocks_test.go -package organization_test
+//
+
+// Package organization_test is a generated GoMock package.
+package organization_test
+
+import (
+	organization "contacts/internal/storage/organization"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockdatabase is a mock of database interface.
+type Mockdatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatabaseMockRecorder
+}
+
+// MockdatabaseMockRecorder is the mock recorder for Mockdatabase.
+type MockdatabaseMockRecorder struct {
+	mock *Mockdatabase
+}
+
+// NewMockdatabase creates a new mock instance.
+func NewMockdatabase(ctrl *gomock.Controller) *Mockdatabase {
+	mock := &Mockdatabase{ctrl: ctrl}
+	mock.recorder = &MockdatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *Mockdatabase) Read() (map[string]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read")
+	ret0, _ := ret[0].(map[string]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockdatabaseMockRecorder) Read() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*Mockdatabase)(nil).Read))
+}
+
+// Save mocks base method.
+func (m *Mockdatabase) Save(organizations map[string]organization.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", organizations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockdatabaseMockRecorder) Save(organizations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*Mockdatabase)(nil).Save), organizations)
+}
@@ -0,0 +1,152 @@
+package organization
+
+import (
+	"fmt"
+	"strings"
+
+	"contacts/internal/model"
+)
+
+// Storage – хранилище организаций, лежит отдельно от базы контактов.
+//
+// Названия организаций не повторяются без учета регистра.
+type Storage struct {
+	db database
+}
+
+func New(db database) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// Fetch – получить список всех организаций
+func (s *Storage) Fetch() ([]model.Organization, error) {
+	organizationsDto, err := s.db.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	organizations := make([]model.Organization, 0, len(organizationsDto))
+	for _, organizationDto := range organizationsDto {
+		organizations = append(organizations, dtoToModel(organizationDto))
+	}
+
+	return organizations, nil
+}
+
+func (s *Storage) FetchByUuid(uuid string) (model.Organization, error) {
+	organizationsDto, err := s.db.Read()
+	if err != nil {
+		return model.Organization{}, err
+	}
+
+	organizationDto, ok := organizationsDto[uuid]
+	if !ok {
+		return model.Organization{}, model.ErrNotFound
+	}
+
+	return dtoToModel(organizationDto), nil
+}
+
+// FetchByName – организация с названием name без учета регистра и пробелов по краям.
+//
+// Если такой нет, возвращает model.ErrNotFound.
+func (s *Storage) FetchByName(name string) (model.Organization, error) {
+	organizationsDto, err := s.db.Read()
+	if err != nil {
+		return model.Organization{}, err
+	}
+
+	for _, organizationDto := range organizationsDto {
+		if sameName(organizationDto.Name, name) {
+			return dtoToModel(organizationDto), nil
+		}
+	}
+
+	return model.Organization{}, model.ErrNotFound
+}
+
+// Create – создать организацию
+//
+// Если организация с таким UUID или названием уже есть, возвращает model.ErrAlreadyExists.
+func (s *Storage) Create(organization model.Organization) error {
+	organizationsDto, err := s.db.Read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := organizationsDto[organization.UUID]; ok {
+		return model.ErrAlreadyExists
+	}
+
+	err = checkName(organizationsDto, organization)
+	if err != nil {
+		return err
+	}
+
+	organizationsDto[organization.UUID] = modelToDto(organization)
+
+	return s.db.Save(organizationsDto)
+}
+
+// Update – перезаписать организацию, например при переименовании
+//
+// Если название занято другой организацией, возвращает model.ErrAlreadyExists.
+func (s *Storage) Update(organization model.Organization) error {
+	organizationsDto, err := s.db.Read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := organizationsDto[organization.UUID]; !ok {
+		return model.ErrNotFound
+	}
+
+	err = checkName(organizationsDto, organization)
+	if err != nil {
+		return err
+	}
+
+	organizationsDto[organization.UUID] = modelToDto(organization)
+
+	return s.db.Save(organizationsDto)
+}
+
+// Delete – удалить организацию по id
+//
+// Ссылки контактов на нее хранилище не проверяет, их переносит обработчик.
+func (s *Storage) Delete(uuid string) error {
+	organizationsDto, err := s.db.Read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := organizationsDto[uuid]; !ok {
+		return model.ErrNotFound
+	}
+
+	delete(organizationsDto, uuid)
+
+	return s.db.Save(organizationsDto)
+}
+
+// checkName – название не совпадает с названием другой организации
+func checkName(organizationsDto map[string]Organization, organization model.Organization) error {
+	for uuid, organizationDto := range organizationsDto {
+		if uuid == organization.UUID {
+			continue
+		}
+
+		if sameName(organizationDto.Name, organization.Name) {
+			return fmt.Errorf("%w: organization %q", model.ErrAlreadyExists, organization.Name)
+		}
+	}
+
+	return nil
+}
+
+// sameName – названия совпадают без учета регистра и пробелов по краям
+func sameName(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
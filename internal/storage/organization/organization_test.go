@@ -0,0 +1,330 @@
+package organization_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"contacts/internal/model"
+	. "contacts/internal/storage/organization"
+)
+
+func TestStorage_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		organization model.Organization
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:         "Failed to read from database",
+			organization: model.Organization{UUID: "1", Name: "Авито"},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			name:         "UUID already exists",
+			organization: model.Organization{UUID: "1", Name: "Авито"},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Яндекс"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrAlreadyExists)
+			},
+		},
+		{
+			name:         "Name already taken ignoring case",
+			organization: model.Organization{UUID: "2", Name: "авито "},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrAlreadyExists)
+			},
+		},
+		{
+			name:         "Success",
+			organization: model.Organization{UUID: "2", Name: "Яндекс"},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+						"2": {UUID: "2", Name: "Яндекс"},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			err := instance.Create(tc.organization)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestStorage_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		organization model.Organization
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name:         "Not found",
+			organization: model.Organization{UUID: "2", Name: "Яндекс"},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:         "Name taken by another organization",
+			organization: model.Organization{UUID: "2", Name: "АВИТО"},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+						"2": {UUID: "2", Name: "Яндекс"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrAlreadyExists)
+			},
+		},
+		{
+			name:         "Rename keeping own name in another case",
+			organization: model.Organization{UUID: "1", Name: "АВИТО"},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Organization{
+						"1": {UUID: "1", Name: "АВИТО"},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			err := instance.Update(tc.organization)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestStorage_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		uuid         string
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name: "Not found",
+			uuid: "2",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name: "Success",
+			uuid: "1",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+						"2": {UUID: "2", Name: "Яндекс"},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Organization{
+						"2": {UUID: "2", Name: "Яндекс"},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			err := instance.Delete(tc.uuid)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestStorage_FetchByName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		search       string
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, actual model.Organization, err error)
+	}{
+		{
+			name:   "Failed to read from database",
+			search: "Авито",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, actual model.Organization, err error) {
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			name:   "Not found",
+			search: "Яндекс",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, actual model.Organization, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:   "Found ignoring case and spaces",
+			search: " авито ",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Organization{
+						"1": {UUID: "1", Name: "Авито"},
+						"2": {UUID: "2", Name: "Яндекс"},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, actual model.Organization, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, model.Organization{UUID: "1", Name: "Авито"}, actual)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			out, err := instance.FetchByName(tc.search)
+
+			tc.expectations(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"contacts/internal/model"
+)
+
+// SetRelations – заменить связи, которые хранятся у контакта.
+//
+// Связанные контакты должны существовать, иначе возвращается model.ErrRelation и ничего не сохраняется.
+// updatedAt – новая дата изменения контакта.
+func (s *Storage) SetRelations(uuid string, relations []model.Relation, updatedAt time.Time) error {
+	return s.modify(uuid, updatedAt, func(contactDto *Contact, contactsDto map[string]Contact) error {
+		contactDto.Relations = relationsToDto(relations)
+		return checkRelations(contactsDto, *contactDto)
+	})
+}
+
+// checkRelations – связи контакта ведут на существующие контакты, кроме него самого
+func checkRelations(contactsDto map[string]Contact, contactDto Contact) error {
+	for _, relationDto := range contactDto.Relations {
+		if !model.RelationType(relationDto.Type).Valid() {
+			return fmt.Errorf("%w: unknown type %q", model.ErrRelation, relationDto.Type)
+		}
+
+		if relationDto.UUID == contactDto.UUID {
+			return fmt.Errorf("%w: contact %s related to itself", model.ErrRelation, relationDto.UUID)
+		}
+
+		if _, ok := contactsDto[relationDto.UUID]; !ok {
+			return fmt.Errorf("%w: contact %s not found", model.ErrRelation, relationDto.UUID)
+		}
+	}
+
+	return nil
+}
+
+// removeRelations – удаляет у оставшихся контактов связи с удаленными, чтобы не было висячих ссылок
+func removeRelations(contactsDto map[string]Contact, deleted []string) {
+	for uuid, contactDto := range contactsDto {
+		relations := slices.DeleteFunc(slices.Clone(contactDto.Relations), func(relationDto Relation) bool {
+			return slices.Contains(deleted, relationDto.UUID)
+		})
+
+		if len(relations) == len(contactDto.Relations) {
+			continue
+		}
+
+		if len(relations) == 0 {
+			relations = nil
+		}
+
+		contactDto.Relations = relations
+		contactsDto[uuid] = contactDto
+	}
+}
+
+// retargetRelations – переносит связи с контакта from на контакт to, например при объединении.
+//
+// Связи контакта to с самим собой и повторы удаляются.
+func retargetRelations(contactsDto map[string]Contact, from, to string) {
+	for uuid, contactDto := range contactsDto {
+		if !slices.ContainsFunc(contactDto.Relations, func(relationDto Relation) bool {
+			return relationDto.UUID == from
+		}) {
+			continue
+		}
+
+		relations := make([]Relation, 0, len(contactDto.Relations))
+		for _, relationDto := range contactDto.Relations {
+			if relationDto.UUID == from {
+				relationDto.UUID = to
+			}
+
+			if relationDto.UUID == uuid || slices.Contains(relations, relationDto) {
+				continue
+			}
+
+			relations = append(relations, relationDto)
+		}
+
+		if len(relations) == 0 {
+			relations = nil
+		}
+
+		contactDto.Relations = relations
+		contactsDto[uuid] = contactDto
+	}
+}
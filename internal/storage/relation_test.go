@@ -0,0 +1,172 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"contacts/internal/model"
+	. "contacts/internal/storage"
+)
+
+func TestStorage_SetRelations(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		uuid         string
+		relations    []model.Relation
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, err error)
+	}{
+		{
+			name: "Failed to read from database",
+			uuid: "1",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Contact not found",
+			uuid: "1",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name: "Related contact not found",
+			uuid: "1",
+			relations: []model.Relation{
+				{Type: model.RelationSpouse, UUID: "2"},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrRelation)
+			},
+		},
+		{
+			name: "Contact related to itself",
+			uuid: "1",
+			relations: []model.Relation{
+				{Type: model.RelationColleague, UUID: "1"},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrRelation)
+			},
+		},
+		{
+			name: "Unknown relation type",
+			uuid: "1",
+			relations: []model.Relation{
+				{Type: "friend", UUID: "2"},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+						"2": {
+							UUID: "2",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrRelation)
+			},
+		},
+		{
+			name: "Success",
+			uuid: "1",
+			relations: []model.Relation{
+				{Type: model.RelationManager, UUID: "2"},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+							Relations: []Relation{
+								{Type: "colleague", UUID: "2"},
+							},
+						},
+						"2": {
+							UUID: "2",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID: "1",
+							Relations: []Relation{
+								{Type: "manager", UUID: "2"},
+							},
+							UpdatedAt: updatedAt,
+						},
+						"2": {
+							UUID: "2",
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			err := instance.SetRelations(tc.uuid, tc.relations, updatedAt)
+
+			tc.expectations(t, err)
+		})
+	}
+}
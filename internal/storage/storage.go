@@ -2,6 +2,7 @@ package storage
 
 import (
 	"strings"
+	"time"
 
 	"golang.org/x/exp/maps"
 
@@ -9,16 +10,23 @@ import (
 )
 
 type Storage struct {
-	db database
+	db            database
+	uniqueIndexes []UniqueIndex
 }
 
-func New(db database) *Storage {
+// New – хранилище контактов.
+//
+// uniqueIndexes – поля, значения которых не должны повторяться у разных контактов.
+func New(db database, uniqueIndexes ...UniqueIndex) *Storage {
 	return &Storage{
-		db: db,
+		db:            db,
+		uniqueIndexes: uniqueIndexes,
 	}
 }
 
-// Search – поиск контактов, которые соответствуют запросу
+// Search – поиск контактов, которые соответствуют запросу.
+//
+// Слова запроса ищутся в имени, фамилии и заметках.
 func (s *Storage) Search(request model.SearchRequest) ([]model.Contact, error) {
 	contactsDto, err := s.db.Read()
 	if err != nil {
@@ -43,7 +51,9 @@ func (s *Storage) Search(request model.SearchRequest) ([]model.Contact, error) {
 
 	for _, contactDto := range contactsDto {
 		for _, word := range words {
-			if strings.Contains(strings.ToLower(contactDto.Name), word) || strings.Contains(strings.ToLower(contactDto.Surname), word) {
+			if strings.Contains(strings.ToLower(contactDto.Name), word) ||
+				strings.Contains(strings.ToLower(contactDto.Surname), word) ||
+				strings.Contains(strings.ToLower(contactDto.Notes), word) {
 				filtered[contactDto.UUID] = dtoToModel(contactDto)
 			}
 		}
@@ -84,7 +94,7 @@ func (s *Storage) Fetch() ([]model.Contact, error) {
 	return contacts, nil
 }
 
-// Delete - удалить контакт по id
+// Delete - удалить контакт по id, связи других контактов с ним тоже удаляются
 func (s *Storage) Delete(uuid string) error {
 	contactsDto, err := s.db.Read()
 	if err != nil {
@@ -92,28 +102,100 @@ func (s *Storage) Delete(uuid string) error {
 	}
 
 	delete(contactsDto, uuid)
+	removeRelations(contactsDto, []string{uuid})
+
+	return s.db.Save(contactsDto)
+}
+
+// DeleteMany – удалить несколько контактов за одно сохранение.
+//
+// Если хотя бы одного контакта нет, ничего не удаляется и возвращается model.ErrNotFound.
+// Связи оставшихся контактов с удаленными тоже удаляются.
+func (s *Storage) DeleteMany(uuids []string) error {
+	contactsDto, err := s.db.Read()
+	if err != nil {
+		return err
+	}
+
+	for _, uuid := range uuids {
+		if _, ok := contactsDto[uuid]; !ok {
+			return model.ErrNotFound
+		}
+	}
+
+	for _, uuid := range uuids {
+		delete(contactsDto, uuid)
+	}
+	removeRelations(contactsDto, uuids)
+
+	return s.db.Save(contactsDto)
+}
+
+// UpdateMany – перезаписать несколько контактов за одно сохранение.
+//
+// Если хотя бы одного контакта нет или уникальность нарушена с запретом,
+// ничего не сохраняется. Предупреждения об уникальности не возвращаются.
+func (s *Storage) UpdateMany(contacts []model.Contact) error {
+	contactsDto, err := s.db.Read()
+	if err != nil {
+		return err
+	}
+
+	for _, contact := range contacts {
+		if _, ok := contactsDto[contact.UUID]; !ok {
+			return model.ErrNotFound
+		}
+
+		contactsDto[contact.UUID] = modelToDto(contact)
+	}
+
+	// Уникальность проверяем после замены всех контактов, чтобы сравнивать с новыми значениями
+	for _, contact := range contacts {
+		violation := s.checkUnique(contactsDto, contactsDto[contact.UUID])
+		if violation != nil && violation.Blocked {
+			return violation
+		}
+
+		err = checkRelations(contactsDto, contactsDto[contact.UUID])
+		if err != nil {
+			return err
+		}
+	}
 
 	return s.db.Save(contactsDto)
 }
 
 // Update – обновить контакт, находим контакт по id и перезаписываем его в хранилище
+//
+// Дата создания, источник, связи и журнал общения берутся из сохраненного контакта, их нельзя перезаписать.
+// Связи меняются через SetRelations, журнал и частота общения – через SetInteractions и SetKeepInTouch.
+// При нарушении уникальности возвращает *model.UniqueViolationError,
+// контакт сохраняется, если нарушены только индексы в режиме предупреждения.
 func (s *Storage) Update(contact model.Contact) error {
 	contactsDto, err := s.db.Read()
 	if err != nil {
 		return err
 	}
 
-	_, ok := contactsDto[contact.UUID]
+	stored, ok := contactsDto[contact.UUID]
 	if !ok {
 		return model.ErrNotFound
 	}
 
-	contactsDto[contact.UUID] = modelToDto(contact)
+	contactDto := modelToDto(contact)
+	contactDto.CreatedAt = stored.CreatedAt
+	contactDto.Source = stored.Source
+	contactDto.Relations = stored.Relations
+	contactDto.Interactions = stored.Interactions
+	contactDto.KeepInTouch = stored.KeepInTouch
 
-	return s.db.Save(contactsDto)
+	return s.save(contactsDto, contactDto, s.checkUnique(contactsDto, contactDto))
 }
 
 // Create – создать контакт
+//
+// При нарушении уникальности возвращает *model.UniqueViolationError,
+// контакт сохраняется, если нарушены только индексы в режиме предупреждения.
 func (s *Storage) Create(contact model.Contact) error {
 	contactsDto, err := s.db.Read()
 	if err != nil {
@@ -125,7 +207,133 @@ func (s *Storage) Create(contact model.Contact) error {
 		return model.ErrAlreadyExists
 	}
 
-	contactsDto[contact.UUID] = modelToDto(contact)
+	contactDto := modelToDto(contact)
+
+	err = checkRelations(contactsDto, contactDto)
+	if err != nil {
+		return err
+	}
+
+	return s.save(contactsDto, contactDto, s.checkUnique(contactsDto, contactDto))
+}
+
+// Merge – заменяет контакт объединенным и удаляет исходный за одно сохранение.
+//
+// Уникальность с исходным контактом не проверяется, т.к. он будет удален.
+// Связи других контактов с исходным переходят на объединенный.
+func (s *Storage) Merge(merged model.Contact, sourceUUID string) error {
+	contactsDto, err := s.db.Read()
+	if err != nil {
+		return err
+	}
+
+	_, ok := contactsDto[merged.UUID]
+	if !ok {
+		return model.ErrNotFound
+	}
+
+	_, ok = contactsDto[sourceUUID]
+	if !ok {
+		return model.ErrNotFound
+	}
+
+	delete(contactsDto, sourceUUID)
+	retargetRelations(contactsDto, sourceUUID, merged.UUID)
+
+	contactDto := modelToDto(merged)
+
+	err = checkRelations(contactsDto, contactDto)
+	if err != nil {
+		return err
+	}
+
+	return s.save(contactsDto, contactDto, s.checkUnique(contactsDto, contactDto))
+}
+
+// Migrate – заполняет метаданные у контактов, сохраненных до их появления.
+//
+// Настоящая дата создания таких контактов неизвестна, поэтому датой создания
+// и изменения становится now, источником – ручной ввод. Если заполнять нечего, база не перезаписывается.
+// Возвращает количество обновленных контактов.
+func (s *Storage) Migrate(now time.Time) (int, error) {
+	contactsDto, err := s.db.Read()
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for uuid, contactDto := range contactsDto {
+		if !contactDto.CreatedAt.IsZero() && contactDto.Source != "" {
+			continue
+		}
+
+		if contactDto.CreatedAt.IsZero() {
+			contactDto.CreatedAt = now
+		}
+		if contactDto.UpdatedAt.IsZero() {
+			contactDto.UpdatedAt = contactDto.CreatedAt
+		}
+		if contactDto.Source == "" {
+			contactDto.Source = string(model.SourceManual)
+		}
+
+		contactsDto[uuid] = contactDto
+		migrated++
+	}
+
+	if migrated == 0 {
+		return 0, nil
+	}
+
+	err = s.db.Save(contactsDto)
+	if err != nil {
+		return 0, err
+	}
+
+	return migrated, nil
+}
+
+// save – записывает контакт, если уникальность не нарушена или нарушена только с предупреждением
+func (s *Storage) save(contactsDto map[string]Contact, contactDto Contact, violation *model.UniqueViolationError) error {
+	if violation != nil && violation.Blocked {
+		return violation
+	}
+
+	contactsDto[contactDto.UUID] = contactDto
+
+	err := s.db.Save(contactsDto)
+	if err != nil {
+		return err
+	}
+
+	if violation != nil {
+		return violation
+	}
+
+	return nil
+}
+
+// modify – изменить сохраненный контакт, не трогая остальные поля, кроме даты изменения.
+//
+// change получает все контакты для проверок, ошибка change отменяет сохранение.
+func (s *Storage) modify(uuid string, updatedAt time.Time, change func(contactDto *Contact, contactsDto map[string]Contact) error) error {
+	contactsDto, err := s.db.Read()
+	if err != nil {
+		return err
+	}
+
+	contactDto, ok := contactsDto[uuid]
+	if !ok {
+		return model.ErrNotFound
+	}
+
+	err = change(&contactDto, contactsDto)
+	if err != nil {
+		return err
+	}
+
+	contactDto.UpdatedAt = updatedAt
+	contactsDto[uuid] = contactDto
 
 	return s.db.Save(contactsDto)
 }
@@ -98,6 +98,39 @@ func TestStorage_Search(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "Match in notes",
+			request: model.SearchRequest{
+				Query: "gophercon",
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID:    "1",
+							Surname: "Ершов",
+							Notes:   "Познакомились на **GopherCon**",
+						},
+						"2": {
+							UUID:    "2",
+							Surname: "Никандров",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, actual []model.Contact, err error) {
+				assert.NoError(t, err)
+
+				assert.Equal(t, []model.Contact{
+					{
+						UUID:    "1",
+						Surname: "Ершов",
+						Links:   map[model.ContactLink]string{},
+						Notes:   "Познакомились на **GopherCon**",
+					},
+				}, actual)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -357,6 +390,49 @@ func TestStorage_Delete(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "Relations with deleted contact are removed",
+			uuid: uuid,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+							Relations: []Relation{
+								{Type: "spouse", UUID: uuid},
+								{Type: "colleague", UUID: "2"},
+							},
+						},
+						"2": {
+							UUID: "2",
+							Relations: []Relation{
+								{Type: "manager", UUID: uuid},
+							},
+						},
+						uuid: {
+							UUID: uuid,
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID: "1",
+							Relations: []Relation{
+								{Type: "colleague", UUID: "2"},
+							},
+						},
+						"2": {
+							UUID: "2",
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -381,22 +457,20 @@ func TestStorage_Delete(t *testing.T) {
 	}
 }
 
-func TestStorage_Update(t *testing.T) {
+func TestStorage_DeleteMany(t *testing.T) {
 	t.Parallel()
 
-	contact := model.Contact{
-		UUID: "1",
-	}
+	uuids := []string{"1", "2"}
 
 	tests := []struct {
 		name         string
-		contact      model.Contact
+		uuids        []string
 		prepare      func(db *Mockdatabase)
 		expectations func(t assert.TestingT, err error)
 	}{
 		{
-			name:    "Failed to read from database",
-			contact: contact,
+			name:  "Failed to read from database",
+			uuids: uuids,
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
@@ -407,14 +481,14 @@ func TestStorage_Update(t *testing.T) {
 			},
 		},
 		{
-			name:    "Not found",
-			contact: contact,
+			name:  "One of contacts not found",
+			uuids: uuids,
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
 					Return(map[string]Contact{
-						"2": {
-							UUID: "2",
+						"1": {
+							UUID: "1",
 						},
 					}, nil)
 			},
@@ -423,8 +497,8 @@ func TestStorage_Update(t *testing.T) {
 			},
 		},
 		{
-			name:    "Failed to save",
-			contact: contact,
+			name:  "Failed to save to database",
+			uuids: uuids,
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
@@ -432,15 +506,13 @@ func TestStorage_Update(t *testing.T) {
 						"1": {
 							UUID: "1",
 						},
+						"2": {
+							UUID: "2",
+						},
 					}, nil)
 
 				db.EXPECT().
-					Save(map[string]Contact{
-						"1": {
-							UUID:  "1",
-							Links: map[string]string{},
-						},
-					}).
+					Save(map[string]Contact{}).
 					Return(assert.AnError)
 			},
 			expectations: func(t assert.TestingT, err error) {
@@ -448,8 +520,8 @@ func TestStorage_Update(t *testing.T) {
 			},
 		},
 		{
-			name:    "Success",
-			contact: contact,
+			name:  "Success",
+			uuids: uuids,
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
@@ -457,13 +529,18 @@ func TestStorage_Update(t *testing.T) {
 						"1": {
 							UUID: "1",
 						},
+						"2": {
+							UUID: "2",
+						},
+						"3": {
+							UUID: "3",
+						},
 					}, nil)
 
 				db.EXPECT().
 					Save(map[string]Contact{
-						"1": {
-							UUID:  "1",
-							Links: map[string]string{},
+						"3": {
+							UUID: "3",
 						},
 					}).
 					Return(nil)
@@ -489,37 +566,37 @@ func TestStorage_Update(t *testing.T) {
 
 			instance := New(mockDatabase)
 
-			err := instance.Update(tc.contact)
+			err := instance.DeleteMany(tc.uuids)
 
 			tc.expectations(t, err)
 		})
 	}
 }
 
-func TestStorage_Create(t *testing.T) {
+func TestStorage_UpdateMany(t *testing.T) {
 	t.Parallel()
 
-	contact := model.Contact{
-		UUID:     "1",
-		Surname:  "Ершов",
-		Name:     "Виталий",
-		Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
-		Phone:    model.NewPhoneFromInt64(79151596781),
-		Email:    "vaershov@avito.ru",
-		Links: map[model.ContactLink]string{
-			model.ContactLinkVk: "vk.com",
+	contacts := []model.Contact{
+		{
+			UUID: "1",
+			Tags: []string{"семья"},
+		},
+		{
+			UUID: "2",
+			Tags: []string{"семья"},
 		},
 	}
 
 	tests := []struct {
-		name         string
-		contact      model.Contact
-		prepare      func(db *Mockdatabase)
-		expectations func(t assert.TestingT, err error)
+		name          string
+		contacts      []model.Contact
+		uniqueIndexes []UniqueIndex
+		prepare       func(db *Mockdatabase)
+		expectations  func(t assert.TestingT, err error)
 	}{
 		{
-			name:    "Failed to read from database",
-			contact: contact,
+			name:     "Failed to read from database",
+			contacts: contacts,
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
@@ -530,8 +607,8 @@ func TestStorage_Create(t *testing.T) {
 			},
 		},
 		{
-			name:    "Contact already exists",
-			contact: contact,
+			name:     "One of contacts not found",
+			contacts: contacts,
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
@@ -542,38 +619,60 @@ func TestStorage_Create(t *testing.T) {
 					}, nil)
 			},
 			expectations: func(t assert.TestingT, err error) {
-				assert.ErrorIs(t, err, model.ErrAlreadyExists)
+				assert.ErrorIs(t, err, model.ErrNotFound)
 			},
 		},
 		{
-			name:    "Failed to save to database",
-			contact: contact,
+			name: "Email is not unique, blocked",
+			contacts: []model.Contact{
+				{
+					UUID:  "1",
+					Email: "vaershov@avito.ru",
+				},
+			},
+			uniqueIndexes: []UniqueIndex{
+				{
+					Field: model.FieldEmail,
+					Mode:  model.UniqueModeBlock,
+				},
+			},
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
 					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
 						"2": {
-							UUID: "2",
+							UUID:  "2",
+							Email: "vaershov@avito.ru",
 						},
 					}, nil)
-
+			},
+			expectations: func(t assert.TestingT, err error) {
+				var violation *model.UniqueViolationError
+				if assert.ErrorAs(t, err, &violation) {
+					assert.True(t, violation.Blocked)
+				}
+			},
+		},
+		{
+			name:     "Failed to save",
+			contacts: contacts,
+			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
-					Save(map[string]Contact{
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
 						"2": {
 							UUID: "2",
 						},
-						"1": {
-							UUID:     "1",
-							Surname:  "Ершов",
-							Name:     "Виталий",
-							Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
-							Phone:    79151596781,
-							Email:    "vaershov@avito.ru",
-							Links: map[string]string{
-								model.ContactLinkVk: "vk.com",
-							},
-						},
-					}).
+					}, nil)
+
+				db.EXPECT().
+					Save(gomock.Any()).
 					Return(assert.AnError)
 			},
 			expectations: func(t assert.TestingT, err error) {
@@ -581,32 +680,37 @@ func TestStorage_Create(t *testing.T) {
 			},
 		},
 		{
-			name:    "Success",
-			contact: contact,
+			name:     "Success",
+			contacts: contacts,
 			prepare: func(db *Mockdatabase) {
 				db.EXPECT().
 					Read().
 					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
 						"2": {
 							UUID: "2",
 						},
+						"3": {
+							UUID: "3",
+						},
 					}, nil)
 
 				db.EXPECT().
 					Save(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Links: map[string]string{},
+							Tags:  []string{"семья"},
+						},
 						"2": {
-							UUID: "2",
+							UUID:  "2",
+							Links: map[string]string{},
+							Tags:  []string{"семья"},
 						},
-						"1": {
-							UUID:     "1",
-							Surname:  "Ершов",
-							Name:     "Виталий",
-							Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
-							Phone:    79151596781,
-							Email:    "vaershov@avito.ru",
-							Links: map[string]string{
-								model.ContactLinkVk: "vk.com",
-							},
+						"3": {
+							UUID: "3",
 						},
 					}).
 					Return(nil)
@@ -630,11 +734,838 @@ func TestStorage_Create(t *testing.T) {
 				tc.prepare(mockDatabase)
 			}
 
-			instance := New(mockDatabase)
+			instance := New(mockDatabase, tc.uniqueIndexes...)
 
-			err := instance.Create(tc.contact)
+			err := instance.UpdateMany(tc.contacts)
 
 			tc.expectations(t, err)
 		})
 	}
 }
+
+func TestStorage_Update(t *testing.T) {
+	t.Parallel()
+
+	contact := model.Contact{
+		UUID: "1",
+	}
+
+	tests := []struct {
+		name          string
+		contact       model.Contact
+		uniqueIndexes []UniqueIndex
+		prepare       func(db *Mockdatabase)
+		expectations  func(t assert.TestingT, err error)
+	}{
+		{
+			name:    "Failed to read from database",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "Not found",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"2": {
+							UUID: "2",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name: "Email is not unique, blocked",
+			contact: model.Contact{
+				UUID:  "1",
+				Email: "vaershov@avito.ru",
+			},
+			uniqueIndexes: []UniqueIndex{
+				{
+					Field: model.FieldEmail,
+					Mode:  model.UniqueModeBlock,
+				},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Email: "vaershov@avito.ru",
+						},
+						"2": {
+							UUID:    "2",
+							Surname: "Ершова",
+							Name:    "Стася",
+							Email:   "vaershov@avito.ru",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				var violation *model.UniqueViolationError
+				if !assert.ErrorAs(t, err, &violation) {
+					return
+				}
+
+				assert.True(t, violation.Blocked)
+				assert.Equal(t, map[model.Field]model.Message{
+					model.FieldEmail: model.NewMessage(model.MsgUniqueEmail, map[string]any{
+						"Field":   "email",
+						"Surname": "Ершова",
+						"Name":    "Стася",
+					}),
+				}, violation.Fields)
+			},
+		},
+		{
+			name: "Own values don't violate uniqueness",
+			contact: model.Contact{
+				UUID:  "1",
+				Email: "vaershov@avito.ru",
+			},
+			uniqueIndexes: []UniqueIndex{
+				{
+					Field: model.FieldEmail,
+					Mode:  model.UniqueModeBlock,
+				},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Email: "vaershov@avito.ru",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Email: "vaershov@avito.ru",
+							Links: map[string]string{},
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "Failed to save",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Links: map[string]string{},
+						},
+					}).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "Success",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Links: map[string]string{},
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Creation metadata is kept",
+			contact: model.Contact{
+				UUID:      "1",
+				CreatedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC),
+				Source:    model.SourceSync,
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID:      "1",
+							CreatedAt: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+							Source:    "import:vcard",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:      "1",
+							Links:     map[string]string{},
+							CreatedAt: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC),
+							Source:    "import:vcard",
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Interactions and keep in touch are kept",
+			contact: model.Contact{
+				UUID: "1",
+				Name: "Иван",
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+							Interactions: []Interaction{
+								{UUID: "i1", Type: "call", Date: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+							},
+							KeepInTouch: 30,
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Name:  "Иван",
+							Links: map[string]string{},
+							Interactions: []Interaction{
+								{UUID: "i1", Type: "call", Date: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+							},
+							KeepInTouch: 30,
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase, tc.uniqueIndexes...)
+
+			err := instance.Update(tc.contact)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestStorage_Create(t *testing.T) {
+	t.Parallel()
+
+	contact := model.Contact{
+		UUID:     "1",
+		Surname:  "Ершов",
+		Name:     "Виталий",
+		Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
+		Phone:    model.NewPhoneFromInt64(79151596781),
+		Email:    "vaershov@avito.ru",
+		Links: map[model.ContactLink]string{
+			model.ContactLinkVk: "vk.com",
+		},
+		Avatar: "hash",
+	}
+
+	tests := []struct {
+		name          string
+		contact       model.Contact
+		uniqueIndexes []UniqueIndex
+		prepare       func(db *Mockdatabase)
+		expectations  func(t assert.TestingT, err error)
+	}{
+		{
+			name:    "Failed to read from database",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "Contact already exists",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrAlreadyExists)
+			},
+		},
+		{
+			name:    "Phone is not unique, blocked",
+			contact: contact,
+			uniqueIndexes: []UniqueIndex{
+				{
+					Field: model.FieldPhone,
+					Mode:  model.UniqueModeBlock,
+				},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"2": {
+							UUID:    "2",
+							Surname: "Зайцев",
+							Name:    "Сергей",
+							Phone:   79151596781,
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				var violation *model.UniqueViolationError
+				if !assert.ErrorAs(t, err, &violation) {
+					return
+				}
+
+				assert.True(t, violation.Blocked)
+				assert.Equal(t, map[model.Field]model.Message{
+					model.FieldPhone: model.NewMessage(model.MsgUniquePhone, map[string]any{
+						"Field":   "phone",
+						"Surname": "Зайцев",
+						"Name":    "Сергей",
+					}),
+				}, violation.Fields)
+			},
+		},
+		{
+			name:    "Email is not unique, saved with warning",
+			contact: contact,
+			uniqueIndexes: []UniqueIndex{
+				{
+					Field: model.FieldPhone,
+					Mode:  model.UniqueModeBlock,
+				},
+				{
+					Field: model.FieldEmail,
+					Mode:  model.UniqueModeWarn,
+				},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"2": {
+							UUID:    "2",
+							Surname: "Зайцев",
+							Name:    "Сергей",
+							Email:   "VAErshov@avito.ru",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"2": {
+							UUID:    "2",
+							Surname: "Зайцев",
+							Name:    "Сергей",
+							Email:   "VAErshov@avito.ru",
+						},
+						"1": {
+							UUID:     "1",
+							Surname:  "Ершов",
+							Name:     "Виталий",
+							Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
+							Phone:    79151596781,
+							Email:    "vaershov@avito.ru",
+							Links: map[string]string{
+								model.ContactLinkVk: "vk.com",
+							},
+							Avatar: "hash",
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				var violation *model.UniqueViolationError
+				if !assert.ErrorAs(t, err, &violation) {
+					return
+				}
+
+				assert.False(t, violation.Blocked)
+				assert.Equal(t, map[model.Field]model.Message{
+					model.FieldEmail: model.NewMessage(model.MsgUniqueEmail, map[string]any{
+						"Field":   "email",
+						"Surname": "Зайцев",
+						"Name":    "Сергей",
+					}),
+				}, violation.Fields)
+			},
+		},
+		{
+			name:    "Failed to save to database",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"2": {
+							UUID: "2",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"2": {
+							UUID: "2",
+						},
+						"1": {
+							UUID:     "1",
+							Surname:  "Ершов",
+							Name:     "Виталий",
+							Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
+							Phone:    79151596781,
+							Email:    "vaershov@avito.ru",
+							Links: map[string]string{
+								model.ContactLinkVk: "vk.com",
+							},
+							Avatar: "hash",
+						},
+					}).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "Success",
+			contact: contact,
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"2": {
+							UUID: "2",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"2": {
+							UUID: "2",
+						},
+						"1": {
+							UUID:     "1",
+							Surname:  "Ершов",
+							Name:     "Виталий",
+							Birthday: time.Date(2001, 1, 10, 0, 0, 0, 0, time.UTC),
+							Phone:    79151596781,
+							Email:    "vaershov@avito.ru",
+							Links: map[string]string{
+								model.ContactLinkVk: "vk.com",
+							},
+							Avatar: "hash",
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase, tc.uniqueIndexes...)
+
+			err := instance.Create(tc.contact)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestStorage_Merge(t *testing.T) {
+	t.Parallel()
+
+	merged := model.Contact{
+		UUID:  "1",
+		Phone: model.NewPhoneFromInt64(79151596781),
+	}
+
+	tests := []struct {
+		name          string
+		merged        model.Contact
+		sourceUUID    string
+		uniqueIndexes []UniqueIndex
+		prepare       func(db *Mockdatabase)
+		expectations  func(t assert.TestingT, err error)
+	}{
+		{
+			name:       "Failed to read from database",
+			merged:     merged,
+			sourceUUID: "2",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:       "Source not found",
+			merged:     merged,
+			sourceUUID: "2",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrNotFound)
+			},
+		},
+		{
+			name:       "Success, source phone doesn't violate uniqueness",
+			merged:     merged,
+			sourceUUID: "2",
+			uniqueIndexes: []UniqueIndex{
+				{
+					Field: model.FieldPhone,
+					Mode:  model.UniqueModeBlock,
+				},
+			},
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+						"2": {
+							UUID:  "2",
+							Phone: 79151596781,
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Phone: 79151596781,
+							Links: map[string]string{},
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:       "Relations with source move to merged contact",
+			merged:     merged,
+			sourceUUID: "2",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+						"2": {
+							UUID: "2",
+						},
+						"3": {
+							UUID: "3",
+							Relations: []Relation{
+								{Type: "colleague", UUID: "1"},
+								{Type: "colleague", UUID: "2"},
+								{Type: "introduced_by", UUID: "2"},
+							},
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:  "1",
+							Phone: 79151596781,
+							Links: map[string]string{},
+						},
+						"3": {
+							UUID: "3",
+							Relations: []Relation{
+								{Type: "colleague", UUID: "1"},
+								{Type: "introduced_by", UUID: "1"},
+							},
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Merged contact can't be related to source",
+			merged: model.Contact{
+				UUID: "1",
+				Relations: []model.Relation{
+					{Type: model.RelationSpouse, UUID: "2"},
+				},
+			},
+			sourceUUID: "2",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+						"2": {
+							UUID: "2",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, err error) {
+				assert.ErrorIs(t, err, model.ErrRelation)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase, tc.uniqueIndexes...)
+
+			err := instance.Merge(tc.merged, tc.sourceUUID)
+
+			tc.expectations(t, err)
+		})
+	}
+}
+
+func TestStorage_Migrate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+	created := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		prepare      func(db *Mockdatabase)
+		expectations func(t assert.TestingT, migrated int, err error)
+	}{
+		{
+			name: "Failed to read from database",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(nil, assert.AnError)
+			},
+			expectations: func(t assert.TestingT, migrated int, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Nothing to migrate, database is not saved",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID:      "1",
+							CreatedAt: created,
+							UpdatedAt: created,
+							Source:    "manual",
+						},
+					}, nil)
+			},
+			expectations: func(t assert.TestingT, migrated int, err error) {
+				assert.NoError(t, err)
+				assert.Zero(t, migrated)
+			},
+		},
+		{
+			name: "Failed to save",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(gomock.Any()).
+					Return(assert.AnError)
+			},
+			expectations: func(t assert.TestingT, migrated int, err error) {
+				assert.Error(t, err)
+				assert.Zero(t, migrated)
+			},
+		},
+		{
+			name: "Success",
+			prepare: func(db *Mockdatabase) {
+				db.EXPECT().
+					Read().
+					Return(map[string]Contact{
+						"1": {
+							UUID: "1",
+						},
+						"2": {
+							UUID:      "2",
+							CreatedAt: created,
+						},
+						"3": {
+							UUID:      "3",
+							CreatedAt: created,
+							UpdatedAt: now,
+							Source:    "import:csv",
+						},
+					}, nil)
+
+				db.EXPECT().
+					Save(map[string]Contact{
+						"1": {
+							UUID:      "1",
+							CreatedAt: now,
+							UpdatedAt: now,
+							Source:    "manual",
+						},
+						"2": {
+							UUID:      "2",
+							CreatedAt: created,
+							UpdatedAt: created,
+							Source:    "manual",
+						},
+						"3": {
+							UUID:      "3",
+							CreatedAt: created,
+							UpdatedAt: now,
+							Source:    "import:csv",
+						},
+					}).
+					Return(nil)
+			},
+			expectations: func(t assert.TestingT, migrated int, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, migrated)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockDatabase := NewMockdatabase(ctrl)
+
+			if tc.prepare != nil {
+				tc.prepare(mockDatabase)
+			}
+
+			instance := New(mockDatabase)
+
+			migrated, err := instance.Migrate(now)
+
+			tc.expectations(t, migrated, err)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"contacts/internal/model"
+)
+
+// UniqueIndex – поле, значение которого не должно повторяться у разных контактов
+type UniqueIndex struct {
+	Field model.Field
+	Mode  model.UniqueMode
+}
+
+// Сообщения об ошибке для полей, у остальных – общее сообщение с названием поля
+var uniqueFieldMessages = map[model.Field]string{
+	model.FieldPhone: model.MsgUniquePhone,
+	model.FieldEmail: model.MsgUniqueEmail,
+}
+
+// uniqueKey – значение поля, по которому проверяется уникальность.
+//
+// Пустая строка означает, что поле не заполнено и не проверяется.
+func uniqueKey(field model.Field, contactDto Contact) string {
+	switch field {
+	case model.FieldPhone:
+		if contactDto.Phone == 0 {
+			return ""
+		}
+		return strconv.FormatInt(contactDto.Phone, 10)
+	case model.FieldEmail:
+		return strings.ToLower(strings.TrimSpace(contactDto.Email))
+	case model.FieldSurname:
+		return strings.ToLower(strings.TrimSpace(contactDto.Surname))
+	case model.FieldName:
+		return strings.ToLower(strings.TrimSpace(contactDto.Name))
+	}
+
+	return ""
+}
+
+// checkUnique – проверяет уникальность полей контакта среди остальных контактов.
+//
+// exclude – контакты, с которыми сравнивать не нужно (например, сам контакт).
+// Возвращает nil, если нарушений нет.
+func (s *Storage) checkUnique(
+	contactsDto map[string]Contact,
+	contactDto Contact,
+	exclude ...string,
+) *model.UniqueViolationError {
+	if len(s.uniqueIndexes) == 0 {
+		return nil
+	}
+
+	excluded := make(map[string]struct{}, len(exclude)+1)
+	excluded[contactDto.UUID] = struct{}{}
+	for _, uuid := range exclude {
+		excluded[uuid] = struct{}{}
+	}
+
+	// Обходим контакты в стабильном порядке, чтобы сообщения не менялись между вызовами
+	uuids := make([]string, 0, len(contactsDto))
+	for uuid := range contactsDto {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+
+	violation := &model.UniqueViolationError{
+		Fields: make(map[model.Field]model.Message),
+	}
+
+	for _, index := range s.uniqueIndexes {
+		key := uniqueKey(index.Field, contactDto)
+		if key == "" {
+			continue
+		}
+
+		for _, uuid := range uuids {
+			if _, ok := excluded[uuid]; ok {
+				continue
+			}
+
+			other := contactsDto[uuid]
+			if uniqueKey(index.Field, other) != key {
+				continue
+			}
+
+			messageID, ok := uniqueFieldMessages[index.Field]
+			if !ok {
+				messageID = model.MsgUniqueField
+			}
+
+			violation.Fields[index.Field] = model.NewMessage(messageID, map[string]any{
+				"Field":   string(index.Field),
+				"Surname": other.Surname,
+				"Name":    other.Name,
+			})
+			if index.Mode == model.UniqueModeBlock {
+				violation.Blocked = true
+			}
+
+			break
+		}
+	}
+
+	if len(violation.Fields) == 0 {
+		return nil
+	}
+
+	return violation
+}
@@ -0,0 +1,102 @@
+package appearance
+
+import (
+	"slices"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+// Variant – светлая, темная или как в системе
+type Variant string
+
+const (
+	VariantSystem Variant = "system"
+	VariantLight  Variant = "light"
+	VariantDark   Variant = "dark"
+)
+
+// Границы масштаба шрифта
+const (
+	MinFontScale = 0.8
+	MaxFontScale = 1.6
+)
+
+// Ключи настроек внешнего вида
+const (
+	variantPreferenceKey   = "appearance.variant"
+	accentPreferenceKey    = "appearance.accent"
+	fontScalePreferenceKey = "appearance.font_scale"
+)
+
+// Settings – выбранные пользователем параметры внешнего вида
+type Settings struct {
+	Variant Variant
+	// Accent – имя акцентного цвета из theme.PrimaryColorNames
+	Accent    string
+	FontScale float64
+}
+
+// DefaultSettings – внешний вид при первом запуске
+func DefaultSettings() Settings {
+	return Settings{
+		Variant:   VariantSystem,
+		Accent:    theme.ColorBlue,
+		FontScale: 1,
+	}
+}
+
+// Variants – варианты темы в порядке показа в настройках
+func Variants() []Variant {
+	return []Variant{VariantSystem, VariantLight, VariantDark}
+}
+
+// Accents – доступные акцентные цвета
+func Accents() []string {
+	return theme.PrimaryColorNames()
+}
+
+// normalize – подставляет значения по умолчанию вместо некорректных
+func (s Settings) normalize() Settings {
+	defaults := DefaultSettings()
+
+	if !slices.Contains(Variants(), s.Variant) {
+		s.Variant = defaults.Variant
+	}
+	if !slices.Contains(Accents(), s.Accent) {
+		s.Accent = defaults.Accent
+	}
+	if s.FontScale < MinFontScale || s.FontScale > MaxFontScale {
+		s.FontScale = defaults.FontScale
+	}
+
+	return s
+}
+
+// Store – хранит настройки внешнего вида в настройках приложения
+type Store struct {
+	preferences preferences
+}
+
+func NewStore(preferences preferences) *Store {
+	return &Store{
+		preferences: preferences,
+	}
+}
+
+func (s *Store) Load() Settings {
+	defaults := DefaultSettings()
+
+	return Settings{
+		Variant:   Variant(s.preferences.StringWithFallback(variantPreferenceKey, string(defaults.Variant))),
+		Accent:    s.preferences.StringWithFallback(accentPreferenceKey, defaults.Accent),
+		FontScale: s.preferences.FloatWithFallback(fontScalePreferenceKey, defaults.FontScale),
+	}.normalize()
+}
+
+func (s *Store) Save(settings Settings) {
+	settings = settings.normalize()
+
+	s.preferences.SetString(variantPreferenceKey, string(settings.Variant))
+	s.preferences.SetString(accentPreferenceKey, settings.Accent)
+	s.preferences.SetFloat(fontScalePreferenceKey, settings.FontScale)
+}
@@ -0,0 +1,8 @@
+package appearance
+
+type preferences interface {
+	StringWithFallback(key, fallback string) string
+	SetString(key string, value string)
+	FloatWithFallback(key string, fallback float64) float64
+	SetFloat(key string, value float64)
+}
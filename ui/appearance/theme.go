@@ -0,0 +1,60 @@
+package appearance
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Размеры, которые меняются вместе с масштабом шрифта
+var scaledSizes = map[fyne.ThemeSizeName]struct{}{
+	theme.SizeNameText:           {},
+	theme.SizeNameCaptionText:    {},
+	theme.SizeNameHeadingText:    {},
+	theme.SizeNameSubHeadingText: {},
+	theme.SizeNameInlineIcon:     {},
+}
+
+// Theme – стандартная тема fyne с учетом настроек пользователя
+type Theme struct {
+	fyne.Theme
+
+	settings Settings
+}
+
+func NewTheme(settings Settings) *Theme {
+	return &Theme{
+		Theme:    theme.DefaultTheme(),
+		settings: settings.normalize(),
+	}
+}
+
+func (t *Theme) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	switch t.settings.Variant {
+	case VariantLight:
+		variant = theme.VariantLight
+	case VariantDark:
+		variant = theme.VariantDark
+	}
+
+	if name == theme.ColorNamePrimary {
+		return theme.PrimaryColorNamed(t.settings.Accent)
+	}
+
+	return t.Theme.Color(name, variant)
+}
+
+func (t *Theme) Size(name fyne.ThemeSizeName) float32 {
+	size := t.Theme.Size(name)
+	if _, ok := scaledSizes[name]; ok {
+		return size * float32(t.settings.FontScale)
+	}
+
+	return size
+}
+
+// Apply – применяет настройки ко всем окнам приложения
+func Apply(appSettings fyne.Settings, settings Settings) {
+	appSettings.SetTheme(NewTheme(settings))
+}
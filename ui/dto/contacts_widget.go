@@ -2,17 +2,28 @@ package dto
 
 import (
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
 )
 
 type ContactWidgetRowType string
 
 const (
-	ContactWidgetRowTypeDatePicker = "date_picker"
-	ContactWidgetRowTypeText       = "text"
+	ContactWidgetRowTypeDatePicker ContactWidgetRowType = "date_picker"
+	ContactWidgetRowTypeText       ContactWidgetRowType = "text"
+	ContactWidgetRowTypeAvatar     ContactWidgetRowType = "avatar"
+	ContactWidgetRowTypePhone      ContactWidgetRowType = "phone" // Поле с маской +7 (915) 159-67-81
+	ContactWidgetRowTypeEmail      ContactWidgetRowType = "email"
+	ContactWidgetRowTypeLink       ContactWidgetRowType = "link"
+	ContactWidgetRowTypeNotes      ContactWidgetRowType = "notes" // Многострочный текст, в просмотре – Markdown
+	// Поле с выбором из Options, можно ввести и новое значение
+	ContactWidgetRowTypeOrganization ContactWidgetRowType = "organization"
 )
 
 type ContactInfoWidgetRowData struct {
+	Field model.Field // По полю окна находят строку, подпись зависит от языка
 	Label string
 	Entry ContactInfoWidgetRowEntry
 }
@@ -21,16 +32,21 @@ type ContactInfoWidgetRowEntry struct {
 	Value       *string
 	Placeholder *string
 	Type        ContactWidgetRowType
-	DisableEdit bool
+	Image       fyne.Resource // Изображение для строки с аватаром
+	Options     []string      // Варианты для строки с организацией
 }
 
 type ContactInfoWidget struct {
-	AssignedByLabel map[string]ContactWidgetRow
+	AssignedByField map[model.Field]ContactWidgetRow
 	Box             *fyne.Container
-	Size            *fyne.Size
+	// SetEditing – переключает виджет между просмотром и формой редактирования на месте
+	SetEditing func(editing bool)
 }
 
 type ContactWidgetRow struct {
-	Label *widget.Label
-	Entry *widget.Entry
+	Label  *widget.Label
+	Entry  *widget.Entry
+	Error  *widget.Label  // Ошибка проверки поля, показывается под ним
+	Image  *canvas.Image  // Только для строки с аватаром
+	Button *widget.Button // Кнопка выбора фото, только для строки с аватаром
 }
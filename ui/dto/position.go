@@ -1,6 +0,0 @@
-package dto
-
-type Position struct {
-	X float32
-	Y float32
-}
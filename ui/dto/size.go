@@ -1,6 +0,0 @@
-package dto
-
-type Size struct {
-	Width  float32
-	Height float32
-}
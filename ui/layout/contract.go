@@ -0,0 +1,6 @@
+package layout
+
+type preferences interface {
+	FloatWithFallback(key string, fallback float64) float64
+	SetFloat(key string, value float64)
+}
@@ -0,0 +1,69 @@
+package layout
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+)
+
+// Ключи настроек, в которых хранится раскладка главного окна
+const (
+	windowWidthPreferenceKey  = "layout.window.width"
+	windowHeightPreferenceKey = "layout.window.height"
+	splitOffsetPreferenceKey  = "layout.split.offset"
+)
+
+// Store – запоминает размер главного окна и положение разделителя между списком и карточкой
+type Store struct {
+	preferences preferences
+
+	defaultSize   fyne.Size
+	defaultOffset float64
+}
+
+func NewStore(preferences preferences, defaultSize fyne.Size, defaultOffset float64) *Store {
+	return &Store{
+		preferences:   preferences,
+		defaultSize:   defaultSize,
+		defaultOffset: defaultOffset,
+	}
+}
+
+// WindowSize – сохраненный размер окна, при первом запуске – размер по умолчанию
+func (s *Store) WindowSize() fyne.Size {
+	width := s.preferences.FloatWithFallback(windowWidthPreferenceKey, float64(s.defaultSize.Width))
+	height := s.preferences.FloatWithFallback(windowHeightPreferenceKey, float64(s.defaultSize.Height))
+
+	// Окно, свернутое до нуля, восстанавливать не нужно
+	if width <= 0 || height <= 0 {
+		return s.defaultSize
+	}
+
+	return fyne.NewSize(float32(width), float32(height))
+}
+
+// SplitOffset – сохраненное положение разделителя, доля от ширины окна
+func (s *Store) SplitOffset() float64 {
+	offset := s.preferences.FloatWithFallback(splitOffsetPreferenceKey, s.defaultOffset)
+	if offset <= 0 || offset >= 1 {
+		return s.defaultOffset
+	}
+
+	return offset
+}
+
+// Restore – применяет сохраненную раскладку к окну и разделителю
+func (s *Store) Restore(window fyne.Window, split *container.Split) {
+	window.Resize(s.WindowSize())
+	split.SetOffset(s.SplitOffset())
+}
+
+// Save – сохраняет текущую раскладку окна и разделителя
+func (s *Store) Save(window fyne.Window, split *container.Split) {
+	size := window.Canvas().Size()
+	if size.Width > 0 && size.Height > 0 {
+		s.preferences.SetFloat(windowWidthPreferenceKey, float64(size.Width))
+		s.preferences.SetFloat(windowHeightPreferenceKey, float64(size.Height))
+	}
+
+	s.preferences.SetFloat(splitOffsetPreferenceKey, split.Offset)
+}
@@ -8,6 +8,10 @@ type app interface {
 
 type contactList interface {
 	SelectedContactUUID() *string
+	SelectedContactUUIDs() []string
+	FocusSearch()
+	SelectNext()
+	SelectPrevious()
 }
 
 type createContactWindow interface {
@@ -16,12 +20,37 @@ type createContactWindow interface {
 
 type deleteContactWindow interface {
 	Build(contactUuid string) fyne.Window
+	BuildMany(contactUuids []string) fyne.Window
+}
+
+type tagContactsWindow interface {
+	Build(contactUuids []string) fyne.Window
+}
+
+type exportContactsDialog interface {
+	Show(contactUuids []string)
 }
 
 type updateContactWindow interface {
 	Build(contactUuid string) fyne.Window
 }
 
+type mergeContactsWindow interface {
+	Build() fyne.Window
+}
+
+type organizationsWindow interface {
+	Build() fyne.Window
+}
+
 type aboutWindow interface {
 	Build() fyne.Window
 }
+
+type settingsWindow interface {
+	Build() fyne.Window
+}
+
+type localizer interface {
+	T(id string) string
+}
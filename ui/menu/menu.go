@@ -2,6 +2,8 @@ package menu
 
 import (
 	"fyne.io/fyne/v2"
+
+	"contacts/ui/shortcut"
 )
 
 type Builder struct {
@@ -10,7 +12,13 @@ type Builder struct {
 	createContactWindow createContactWindow
 	updateContactWindow updateContactWindow
 	deleteContactWindow deleteContactWindow
+	tagContactsWindow   tagContactsWindow
+	exportContacts      exportContactsDialog
+	mergeContactsWindow mergeContactsWindow
+	organizationsWindow organizationsWindow
 	aboutWindow         aboutWindow
+	settingsWindow      settingsWindow
+	localizer           localizer
 }
 
 func NewBuilder(
@@ -19,7 +27,13 @@ func NewBuilder(
 	createContactWindow createContactWindow,
 	updateContactWindow updateContactWindow,
 	deleteContactWindow deleteContactWindow,
+	tagContactsWindow tagContactsWindow,
+	exportContacts exportContactsDialog,
+	mergeContactsWindow mergeContactsWindow,
+	organizationsWindow organizationsWindow,
 	aboutWindow aboutWindow,
+	settingsWindow settingsWindow,
+	localizer localizer,
 ) *Builder {
 	return &Builder{
 		app:                 app,
@@ -27,56 +41,159 @@ func NewBuilder(
 		createContactWindow: createContactWindow,
 		updateContactWindow: updateContactWindow,
 		deleteContactWindow: deleteContactWindow,
+		tagContactsWindow:   tagContactsWindow,
+		exportContacts:      exportContacts,
+		mergeContactsWindow: mergeContactsWindow,
+		organizationsWindow: organizationsWindow,
 		aboutWindow:         aboutWindow,
+		settingsWindow:      settingsWindow,
+		localizer:           localizer,
 	}
 }
 
 func (b *Builder) Build() *fyne.MainMenu {
 	// Закрываем приложение
-	exit := fyne.NewMenuItem("Exit", func() {
-		b.app.Quit()
+	exit := fyne.NewMenuItem(b.localizer.T("menu.exit"), b.app.Quit)
+	exit.Shortcut = shortcut.Exit
+
+	// Настройки внешнего вида
+	settings := fyne.NewMenuItem(b.localizer.T("menu.settings"), func() {
+		window := b.settingsWindow.Build()
+		window.Show()
 	})
 
-	file := fyne.NewMenu("File", exit)
+	file := fyne.NewMenu(b.localizer.T("menu.file"), settings, fyne.NewMenuItemSeparator(), exit)
 
 	// Создание контакта
-	createContact := fyne.NewMenuItem("Add contact", func() {
-		window := b.createContactWindow.Build()
-		window.Show()
-	})
+	createContact := fyne.NewMenuItem(b.localizer.T("menu.contact.add"), b.createContact)
+	createContact.Shortcut = shortcut.CreateContact
+
 	// Изменение контакта
-	updateContact := fyne.NewMenuItem("Edit contact", func() {
-		uuid := b.contactList.SelectedContactUUID()
+	updateContact := fyne.NewMenuItem(b.localizer.T("menu.contact.edit"), b.updateContact)
+	updateContact.Shortcut = shortcut.EditContact
 
-		// Если контакт не выбран, то ничего не делаем
-		if uuid == nil {
-			return
-		}
+	// Удаление контакта
+	deleteContact := fyne.NewMenuItem(b.localizer.T("menu.contact.remove"), b.deleteContact)
+	deleteContact.Shortcut = shortcut.RemoveContact
 
-		window := b.updateContactWindow.Build(*uuid)
-		window.Show()
+	// Метка и экспорт для всех выбранных контактов
+	tagContacts := fyne.NewMenuItem(b.localizer.T("menu.contact.tag"), b.tagContacts)
+	exportContacts := fyne.NewMenuItem(b.localizer.T("menu.contact.export"), func() {
+		b.exportContacts.Show(b.contactList.SelectedContactUUIDs())
 	})
-	// Удаление контакта
-	deleteContact := fyne.NewMenuItem("Remove contact", func() {
-		uuid := b.contactList.SelectedContactUUID()
 
-		// Если контакт не выбран, то ничего не делаем
-		if uuid == nil {
-			return
-		}
+	// Поиск и объединение дубликатов
+	mergeContacts := fyne.NewMenuItem(b.localizer.T("menu.duplicates"), func() {
+		window := b.mergeContactsWindow.Build()
+		window.Show()
+	})
 
-		window := b.deleteContactWindow.Build(*uuid)
+	// Организации: переименование, объединение и сотрудники
+	organizations := fyne.NewMenuItem(b.localizer.T("menu.organizations"), func() {
+		window := b.organizationsWindow.Build()
 		window.Show()
 	})
 
-	edit := fyne.NewMenu("Edit", createContact, updateContact, deleteContact)
+	edit := fyne.NewMenu(
+		b.localizer.T("menu.edit"),
+		createContact,
+		updateContact,
+		deleteContact,
+		fyne.NewMenuItemSeparator(),
+		tagContacts,
+		exportContacts,
+		fyne.NewMenuItemSeparator(),
+		mergeContacts,
+		organizations,
+	)
 
-	about := fyne.NewMenuItem("About app", func() {
+	about := fyne.NewMenuItem(b.localizer.T("menu.about"), func() {
 		window := b.aboutWindow.Build()
 		window.Show()
 	})
 
-	info := fyne.NewMenu("Info", about)
+	info := fyne.NewMenu(b.localizer.T("menu.info"), about)
 
 	return fyne.NewMainMenu(file, edit, info)
 }
+
+// BindShortcuts – сочетания клавиш главного окна, те же, что подписаны в меню.
+//
+// Клавиши без модификатора срабатывают, когда фокус не в поле ввода.
+func (b *Builder) BindShortcuts(canvas fyne.Canvas) {
+	canvas.AddShortcut(shortcut.CreateContact, func(fyne.Shortcut) {
+		b.createContact()
+	})
+	canvas.AddShortcut(shortcut.FindContact, func(fyne.Shortcut) {
+		b.contactList.FocusSearch()
+	})
+	canvas.AddShortcut(shortcut.Exit, func(fyne.Shortcut) {
+		b.app.Quit()
+	})
+
+	canvas.SetOnTypedKey(func(event *fyne.KeyEvent) {
+		switch event.Name {
+		case fyne.KeyReturn, fyne.KeyEnter, shortcut.EditContact.KeyName:
+			b.updateContact()
+		case shortcut.RemoveContact.KeyName:
+			b.deleteContact()
+		case fyne.KeyUp:
+			b.contactList.SelectPrevious()
+		case fyne.KeyDown:
+			b.contactList.SelectNext()
+		}
+	})
+}
+
+func (b *Builder) createContact() {
+	window := b.createContactWindow.Build()
+	window.Show()
+}
+
+func (b *Builder) updateContact() {
+	uuid := b.contactList.SelectedContactUUID()
+
+	// Если контакт не выбран, то ничего не делаем
+	if uuid == nil {
+		return
+	}
+
+	// Если контакт не удалось прочитать, ошибку уже показали
+	window := b.updateContactWindow.Build(*uuid)
+	if window == nil {
+		return
+	}
+	window.Show()
+}
+
+func (b *Builder) deleteContact() {
+	uuids := b.contactList.SelectedContactUUIDs()
+
+	// Окно удаления само спрашивает подтверждение
+	// Если контакты не удалось прочитать, ошибку уже показали
+	var window fyne.Window
+	switch len(uuids) {
+	case 0:
+		// Если контакт не выбран, то ничего не делаем
+		return
+	case 1:
+		window = b.deleteContactWindow.Build(uuids[0])
+	default:
+		window = b.deleteContactWindow.BuildMany(uuids)
+	}
+
+	if window == nil {
+		return
+	}
+	window.Show()
+}
+
+func (b *Builder) tagContacts() {
+	uuids := b.contactList.SelectedContactUUIDs()
+	if len(uuids) == 0 {
+		return
+	}
+
+	window := b.tagContactsWindow.Build(uuids)
+	window.Show()
+}
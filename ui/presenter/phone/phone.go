@@ -8,8 +8,9 @@ import (
 func Present(phone int64) string {
 	phoneStr := strconv.FormatInt(phone, 10)
 
+	// Номер, который не удалось разобрать, показываем как есть
 	if len(phoneStr) != 11 {
-		return "Неверный номер телефона"
+		return phoneStr
 	}
 
 	formatted := fmt.Sprintf("+7 (%s) %s-%s-%s",
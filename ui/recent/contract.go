@@ -0,0 +1,10 @@
+package recent
+
+type preferences interface {
+	StringList(key string) []string
+	SetStringList(key string, value []string)
+}
+
+type tracker interface {
+	Push(uuids []string, uuid string) []string
+}
@@ -0,0 +1,27 @@
+package recent
+
+// Ключ настроек со списком недавно открытых контактов
+const recentPreferenceKey = "contacts.recent"
+
+// Store – недавно открытые контакты, переживают перезапуск приложения
+type Store struct {
+	preferences preferences
+	tracker     tracker
+}
+
+func NewStore(preferences preferences, tracker tracker) *Store {
+	return &Store{
+		preferences: preferences,
+		tracker:     tracker,
+	}
+}
+
+// List – UUID контактов, последний открытый – первый
+func (s *Store) List() []string {
+	return s.preferences.StringList(recentPreferenceKey)
+}
+
+// Add – запомнить, что контакт открыли
+func (s *Store) Add(uuid string) {
+	s.preferences.SetStringList(recentPreferenceKey, s.tracker.Push(s.List(), uuid))
+}
@@ -0,0 +1,161 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+
+	"contacts/internal/model"
+)
+
+const (
+	// Ключ настроек, в котором хранятся уже отправленные напоминания
+	sentPreferenceKey = "reminder.birthday.sent"
+	// Сколько последних отправленных напоминаний помнить
+	sentLimit = 200
+)
+
+// BirthdayJob – задача планировщика, которая отправляет уведомления о днях рождения.
+//
+// Отправленные напоминания запоминаются в настройках приложения,
+// поэтому после перезапуска они не повторяются.
+type BirthdayJob struct {
+	notifier         notifier
+	preferences      preferences
+	birthdaysHandler birthdaysHandler
+	planner          planner
+	localizer        localizer
+	dates            dates
+
+	// Вызывается после каждого запуска со списком сегодняшних дней рождения
+	onToday func(today []model.UpcomingBirthday)
+
+	mu           sync.Mutex
+	snoozedUntil time.Time
+}
+
+func NewBirthdayJob(
+	notifier notifier,
+	preferences preferences,
+	birthdaysHandler birthdaysHandler,
+	planner planner,
+	localizer localizer,
+	dates dates,
+) *BirthdayJob {
+	return &BirthdayJob{
+		notifier:         notifier,
+		preferences:      preferences,
+		birthdaysHandler: birthdaysHandler,
+		planner:          planner,
+		localizer:        localizer,
+		dates:            dates,
+	}
+}
+
+// OnToday – подписка на список сегодняшних дней рождения, например для меню в трее
+func (j *BirthdayJob) OnToday(onToday func(today []model.UpcomingBirthday)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.onToday = onToday
+}
+
+// Snooze – отложить уведомления до указанного момента.
+//
+// Неотправленные за это время напоминания придут после его окончания.
+func (j *BirthdayJob) Snooze(until time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.snoozedUntil = until
+}
+
+func (j *BirthdayJob) Run(ctx context.Context, now time.Time) {
+	birthdays, err := j.birthdaysHandler.Upcoming(ctx, j.planner.DaysBefore())
+	if err != nil {
+		slog.Error("birthday reminder", "operation", "birthdays.load", "error", err)
+		return
+	}
+
+	j.mu.Lock()
+	onToday := j.onToday
+	snoozed := now.Before(j.snoozedUntil)
+	j.mu.Unlock()
+
+	if onToday != nil {
+		today := make([]model.UpcomingBirthday, 0)
+		for _, birthday := range birthdays {
+			if birthday.DaysLeft == 0 {
+				today = append(today, birthday)
+			}
+		}
+
+		onToday(today)
+	}
+
+	if snoozed {
+		return
+	}
+
+	sent := j.preferences.StringList(sentPreferenceKey)
+
+	sentSet := make(map[string]struct{}, len(sent))
+	for _, key := range sent {
+		sentSet[key] = struct{}{}
+	}
+
+	changed := false
+
+	for _, reminder := range j.planner.Due(now, birthdays) {
+		if _, ok := sentSet[reminder.Key]; ok {
+			continue
+		}
+
+		j.notifier.SendNotification(j.notification(reminder.Birthday))
+
+		sent = append(sent, reminder.Key)
+		sentSet[reminder.Key] = struct{}{}
+		changed = true
+	}
+
+	// Старые напоминания больше не понадобятся
+	if len(sent) > sentLimit {
+		sent = sent[len(sent)-sentLimit:]
+		changed = true
+	}
+
+	// Настройки сохраняются на диск, без изменений их незачем перезаписывать каждую минуту
+	if changed {
+		j.preferences.SetStringList(sentPreferenceKey, sent)
+	}
+}
+
+func (j *BirthdayJob) notification(birthday model.UpcomingBirthday) *fyne.Notification {
+	params := map[string]any{
+		"Name": fmt.Sprintf("%s %s", birthday.Contact.Name, birthday.Contact.Surname),
+		"Date": j.dates.FormatShort(birthday.Date),
+		"Age":  birthday.Age,
+	}
+
+	// Год рождения неизвестен – возраст не показываем
+	suffix := ""
+	if birthday.Age == 0 {
+		suffix = ".noage"
+	}
+
+	if birthday.DaysLeft == 0 {
+		return fyne.NewNotification(
+			j.localizer.T("reminder.today.title"),
+			j.localizer.Format("reminder.today.body"+suffix, params),
+		)
+	}
+
+	return fyne.NewNotification(
+		j.localizer.T("reminder.soon.title"),
+		j.localizer.Format("reminder.soon.body"+suffix, params),
+	)
+}
@@ -0,0 +1,46 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"fyne.io/fyne/v2"
+
+	"contacts/internal/model"
+)
+
+type notifier interface {
+	SendNotification(notification *fyne.Notification)
+}
+
+type preferences interface {
+	StringList(key string) []string
+	SetStringList(key string, value []string)
+}
+
+type birthdaysHandler interface {
+	Upcoming(ctx context.Context, days int) ([]model.UpcomingBirthday, error)
+}
+
+type planner interface {
+	DaysBefore() int
+	Due(now time.Time, birthdays []model.UpcomingBirthday) []model.Reminder
+}
+
+type interactionHandler interface {
+	Due(ctx context.Context) ([]model.ReconnectDue, error)
+}
+
+type reconnectPlanner interface {
+	Reconnects(now time.Time, due []model.ReconnectDue) []model.ReconnectReminder
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+}
+
+type dates interface {
+	Format(t time.Time) string
+	FormatShort(t time.Time) string
+}
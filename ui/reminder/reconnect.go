@@ -0,0 +1,127 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+
+	"contacts/internal/model"
+)
+
+// Ключ настроек, в котором хранятся уже отправленные напоминания связаться
+const reconnectSentPreferenceKey = "reminder.reconnect.sent"
+
+// ReconnectJob – задача планировщика, которая напоминает связаться с теми, с кем давно не общались.
+//
+// Как и о днях рождения, отправленные напоминания запоминаются в настройках приложения.
+type ReconnectJob struct {
+	notifier           notifier
+	preferences        preferences
+	interactionHandler interactionHandler
+	planner            reconnectPlanner
+	localizer          localizer
+	dates              dates
+
+	// Вызывается после каждого запуска со списком тех, с кем пора связаться
+	onDue func(due []model.ReconnectDue)
+
+	mu sync.Mutex
+}
+
+func NewReconnectJob(
+	notifier notifier,
+	preferences preferences,
+	interactionHandler interactionHandler,
+	planner reconnectPlanner,
+	localizer localizer,
+	dates dates,
+) *ReconnectJob {
+	return &ReconnectJob{
+		notifier:           notifier,
+		preferences:        preferences,
+		interactionHandler: interactionHandler,
+		planner:            planner,
+		localizer:          localizer,
+		dates:              dates,
+	}
+}
+
+// OnDue – подписка на список тех, с кем пора связаться, например для панели в главном окне
+func (j *ReconnectJob) OnDue(onDue func(due []model.ReconnectDue)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.onDue = onDue
+}
+
+func (j *ReconnectJob) Run(ctx context.Context, now time.Time) {
+	due, err := j.interactionHandler.Due(ctx)
+	if err != nil {
+		slog.Error("reconnect reminder", "operation", "reconnect.load", "error", err)
+		return
+	}
+
+	j.mu.Lock()
+	onDue := j.onDue
+	j.mu.Unlock()
+
+	if onDue != nil {
+		onDue(due)
+	}
+
+	sent := j.preferences.StringList(reconnectSentPreferenceKey)
+
+	sentSet := make(map[string]struct{}, len(sent))
+	for _, key := range sent {
+		sentSet[key] = struct{}{}
+	}
+
+	changed := false
+
+	for _, reminder := range j.planner.Reconnects(now, due) {
+		if _, ok := sentSet[reminder.Key]; ok {
+			continue
+		}
+
+		j.notifier.SendNotification(j.notification(reminder.Due))
+
+		sent = append(sent, reminder.Key)
+		sentSet[reminder.Key] = struct{}{}
+		changed = true
+	}
+
+	// Старые напоминания больше не понадобятся
+	if len(sent) > sentLimit {
+		sent = sent[len(sent)-sentLimit:]
+		changed = true
+	}
+
+	if changed {
+		j.preferences.SetStringList(reconnectSentPreferenceKey, sent)
+	}
+}
+
+func (j *ReconnectJob) notification(due model.ReconnectDue) *fyne.Notification {
+	name := strings.TrimSpace(fmt.Sprintf("%s %s", due.Contact.Name, due.Contact.Surname))
+
+	// С контактом еще ни разу не общались
+	if due.LastContacted.IsZero() {
+		return fyne.NewNotification(
+			j.localizer.T("reminder.reconnect.title"),
+			j.localizer.Format("reminder.reconnect.body.never", map[string]any{"Name": name}),
+		)
+	}
+
+	return fyne.NewNotification(
+		j.localizer.T("reminder.reconnect.title"),
+		j.localizer.Format("reminder.reconnect.body", map[string]any{
+			"Name": name,
+			"Date": j.dates.Format(due.LastContacted),
+		}),
+	)
+}
@@ -0,0 +1,5 @@
+package report
+
+type localizer interface {
+	T(id string) string
+}
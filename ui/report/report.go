@@ -0,0 +1,81 @@
+package report
+
+import (
+	"log/slog"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+var dialogSize = fyne.NewSize(420, 200)
+
+// Reporter – сообщает пользователю об ошибках вместо падения приложения.
+//
+// Каждая ошибка пишется в лог с операцией и контекстом,
+// а в окне показывается диалог, по возможности с повтором.
+type Reporter struct {
+	logger    *slog.Logger
+	localizer localizer
+
+	// Окно для диалогов, если ошибка произошла вне своего окна
+	window fyne.Window
+}
+
+func NewReporter(logger *slog.Logger, localizer localizer) *Reporter {
+	return &Reporter{
+		logger:    logger,
+		localizer: localizer,
+	}
+}
+
+// SetWindow – главное окно, в котором показываются ошибки без своего окна
+func (r *Reporter) SetWindow(window fyne.Window) {
+	r.window = window
+}
+
+// Log – только запись в лог, для ошибок, которые уже показаны в интерфейсе.
+//
+// operation – что делали, например "contact.delete", attrs – пары ключ-значение для slog.
+func (r *Reporter) Log(operation string, err error, attrs ...any) {
+	r.logger.Error("operation failed", append([]any{"operation", operation, "error", err}, attrs...)...)
+}
+
+// Error – запись в лог и диалог с текстом error.<operation>.
+//
+// Если retry не nil, в диалоге есть кнопка повтора. window == nil – диалог в главном окне.
+func (r *Reporter) Error(window fyne.Window, operation string, err error, retry func(), attrs ...any) {
+	r.Log(operation, err, attrs...)
+
+	if window == nil {
+		window = r.window
+	}
+	if window == nil {
+		return
+	}
+
+	message := widget.NewLabel(r.localizer.T("error."+operation) + "\n\n" + err.Error())
+	message.Wrapping = fyne.TextWrapWord
+
+	title := r.localizer.T("error.title")
+
+	var d dialog.Dialog
+	if retry == nil {
+		d = dialog.NewCustom(title, r.localizer.T("button.close"), message, window)
+	} else {
+		d = dialog.NewCustomConfirm(title, r.localizer.T("error.retry"), r.localizer.T("button.close"), message, func(confirmed bool) {
+			if confirmed {
+				retry()
+			}
+		}, window)
+	}
+
+	// Перенос строк в лейбле работает только при заданной ширине
+	d.Resize(dialogSize)
+	d.Show()
+}
+
+// Message – текст ошибки операции для показа внутри виджета
+func (r *Reporter) Message(operation string) string {
+	return r.localizer.T("error." + operation)
+}
@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"embed"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+//go:embed icons/*.png icons/*.svg
+var icons embed.FS
+
+// Иконки вшиты в бинарник, поэтому приложение не зависит от рабочего каталога
+var (
+	AppIcon        = mustLoad("app.png")
+	CreateIcon     = mustLoad("plus.png")
+	EditIcon       = mustLoad("edit.png")
+	DeleteIcon     = mustLoad("minus.png")
+	DatePickerIcon = mustLoad("datepicker.png")
+	WarningIcon    = mustLoad("warning.png")
+
+	// Векторные иконки перекрашиваются под тему: контур – цветом текста, заливка – акцентом
+	FavoriteIcon    fyne.Resource = theme.NewPrimaryThemedResource(mustLoad("star.svg"))
+	NotFavoriteIcon fyne.Resource = theme.NewThemedResource(mustLoad("star_outline.svg"))
+)
+
+// mustLoad – ресурс из вшитого каталога icons.
+//
+// Файлы проверяются при компиляции go:embed, поэтому ошибка здесь – ошибка сборки.
+func mustLoad(name string) fyne.Resource {
+	content, err := icons.ReadFile("icons/" + name)
+	if err != nil {
+		panic(err)
+	}
+
+	return fyne.NewStaticResource(name, content)
+}
@@ -0,0 +1,28 @@
+package shortcut
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// Сочетания клавиш главного окна.
+//
+// Используются и для регистрации в окне, и для подписей в меню.
+// Сочетания без модификатора fyne не считает шорткатами,
+// поэтому они обрабатываются через нажатия клавиш.
+var (
+	CreateContact = &desktop.CustomShortcut{KeyName: fyne.KeyN, Modifier: fyne.KeyModifierShortcutDefault}
+	FindContact   = &desktop.CustomShortcut{KeyName: fyne.KeyF, Modifier: fyne.KeyModifierShortcutDefault}
+	EditContact   = &desktop.CustomShortcut{KeyName: fyne.KeyF2}
+	RemoveContact = &desktop.CustomShortcut{KeyName: fyne.KeyDelete}
+	Exit          = &desktop.CustomShortcut{KeyName: fyne.KeyQ, Modifier: fyne.KeyModifierShortcutDefault}
+)
+
+// CloseOnEscape – закрывать окно по Esc
+func CloseOnEscape(window fyne.Window) {
+	window.Canvas().SetOnTypedKey(func(event *fyne.KeyEvent) {
+		if event.Name == fyne.KeyEscape {
+			window.Close()
+		}
+	})
+}
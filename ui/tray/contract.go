@@ -0,0 +1,33 @@
+package tray
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+type app interface {
+	Quit()
+}
+
+type trayApp interface {
+	SetSystemTrayMenu(menu *fyne.Menu)
+	SetSystemTrayIcon(icon fyne.Resource)
+}
+
+type window interface {
+	Show()
+	RequestFocus()
+}
+
+type snoozer interface {
+	Snooze(until time.Time)
+}
+
+type clock interface {
+	Now() time.Time
+}
+
+type localizer interface {
+	T(id string) string
+}
@@ -0,0 +1,104 @@
+package tray
+
+import (
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+
+	"contacts/internal/model"
+	"contacts/ui/resources"
+)
+
+// Насколько можно отложить напоминания из меню
+var snoozeOptions = []struct {
+	messageID string
+	duration  time.Duration
+}{
+	{messageID: "tray.snooze.hour", duration: time.Hour},
+	{messageID: "tray.snooze.day", duration: 24 * time.Hour},
+}
+
+type Builder struct {
+	app       app
+	trayApp   trayApp
+	window    window
+	snoozer   snoozer
+	clock     clock
+	localizer localizer
+}
+
+func NewBuilder(
+	app app,
+	trayApp trayApp,
+	window window,
+	snoozer snoozer,
+	clock clock,
+	localizer localizer,
+) *Builder {
+	return &Builder{
+		app:       app,
+		trayApp:   trayApp,
+		window:    window,
+		snoozer:   snoozer,
+		clock:     clock,
+		localizer: localizer,
+	}
+}
+
+// Build – значок и меню в системном трее, пока без дней рождения
+func (b *Builder) Build() {
+	b.trayApp.SetSystemTrayIcon(resources.AppIcon)
+	b.Refresh(nil)
+}
+
+// Refresh – перестраивает меню трея со списком сегодняшних дней рождения
+func (b *Builder) Refresh(today []model.UpcomingBirthday) {
+	items := []*fyne.MenuItem{
+		fyne.NewMenuItem(b.localizer.T("tray.open"), func() {
+			b.window.Show()
+			b.window.RequestFocus()
+		}),
+		fyne.NewMenuItemSeparator(),
+	}
+
+	if len(today) == 0 {
+		title := fyne.NewMenuItem(b.localizer.T("tray.today.none"), nil)
+		title.Disabled = true
+		items = append(items, title)
+	} else {
+		title := fyne.NewMenuItem(b.localizer.T("tray.today.title"), nil)
+		title.Disabled = true
+		items = append(items, title)
+
+		for _, birthday := range today {
+			label := fmt.Sprintf("%s %s", birthday.Contact.Surname, birthday.Contact.Name)
+			if birthday.Age > 0 {
+				label = fmt.Sprintf("%s, %d", label, birthday.Age)
+			}
+			items = append(items, fyne.NewMenuItem(label, func() {
+				b.window.Show()
+				b.window.RequestFocus()
+			}))
+		}
+	}
+
+	items = append(items, fyne.NewMenuItemSeparator())
+
+	for _, option := range snoozeOptions {
+		duration := option.duration
+		items = append(items, fyne.NewMenuItem(b.localizer.T(option.messageID), func() {
+			b.snoozer.Snooze(b.clock.Now().Add(duration))
+		}))
+	}
+
+	// Без пункта IsQuit fyne добавит в меню трея свой пункт "Quit"
+	quit := fyne.NewMenuItem(b.localizer.T("tray.quit"), func() {
+		b.app.Quit()
+	})
+	quit.IsQuit = true
+
+	items = append(items, fyne.NewMenuItemSeparator(), quit)
+
+	b.trayApp.SetSystemTrayMenu(fyne.NewMenu("", items...))
+}
@@ -0,0 +1,94 @@
+package avatar
+
+import (
+	"context"
+	"io"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+)
+
+var allowedExtensions = []string{".png", ".jpg", ".jpeg"}
+
+type Builder struct {
+	avatarHandler avatarHandler
+
+	// Уже загруженные миниатюры, чтобы не читать их с диска при каждой отрисовке списка
+	cache map[string]fyne.Resource
+}
+
+func NewBuilder(avatarHandler avatarHandler) *Builder {
+	return &Builder{
+		avatarHandler: avatarHandler,
+		cache:         make(map[string]fyne.Resource),
+	}
+}
+
+// Resource – миниатюра по хэшу.
+//
+// Если у контакта нет фото или его не удалось прочитать, возвращается иконка-заглушка.
+func (b *Builder) Resource(hash string) fyne.Resource {
+	if hash == "" {
+		return theme.AccountIcon()
+	}
+
+	if resource, ok := b.cache[hash]; ok {
+		return resource
+	}
+
+	data, err := b.avatarHandler.Fetch(context.Background(), hash)
+	if err != nil {
+		return theme.AccountIcon()
+	}
+
+	resource := fyne.NewStaticResource(hash+".png", data)
+	b.cache[hash] = resource
+
+	return resource
+}
+
+// Build – изображение аватара заданного размера
+func (b *Builder) Build(hash string, size fyne.Size) *canvas.Image {
+	image := canvas.NewImageFromResource(b.Resource(hash))
+	image.FillMode = canvas.ImageFillContain
+	image.SetMinSize(size)
+	image.Resize(size)
+
+	return image
+}
+
+// Pick – открывает диалог выбора файла, делает из выбранного изображения миниатюру
+// и передает ее хэш в onPicked.
+func (b *Builder) Pick(window fyne.Window, onPicked func(hash string)) {
+	fileOpen := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+
+		// Пользователь закрыл диалог, ничего не выбрав
+		if reader == nil {
+			return
+		}
+		defer reader.Close()
+
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+
+		hash, err := b.avatarHandler.Import(context.Background(), data)
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+
+		onPicked(hash)
+	}, window)
+	fileOpen.SetFilter(storage.NewExtensionFileFilter(allowedExtensions))
+	fileOpen.Show()
+}
@@ -0,0 +1,8 @@
+package avatar
+
+import "context"
+
+type avatarHandler interface {
+	Import(ctx context.Context, data []byte) (string, error)
+	Fetch(ctx context.Context, hash string) ([]byte, error)
+}
@@ -1,75 +1,160 @@
 package birthday
 
 import (
+	"context"
 	"image/color"
-	"strings"
-	"time"
+	"slices"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
 
 	"contacts/internal/model"
+	"contacts/ui/resources"
+	"contacts/ui/widget/panel"
 )
 
-var rectangleSize = fyne.NewSize(500, 100)
+var (
+	iconSize = fyne.NewSize(40, 40)
+	// Минимальная высота списка, чтобы панель не схлопывалась
+	listMinHeight float32 = 160
+)
+
+// Горизонты, между которыми переключается панель, в днях
+var defaultHorizons = []int{7, 30}
 
 type Builder struct {
-	windowSize fyne.Size
+	birthdaysHandler birthdaysHandler
+	reporter         reporter
+	localizer        localizer
+	dates            dates
+	horizon          int
 }
 
-func NewBuilder(windowSize fyne.Size) *Builder {
+// NewBuilder – horizon – горизонт в днях, выбранный при запуске
+func NewBuilder(birthdaysHandler birthdaysHandler, reporter reporter, localizer localizer, dates dates, horizon int) *Builder {
 	return &Builder{
-		windowSize: windowSize,
+		birthdaysHandler: birthdaysHandler,
+		reporter:         reporter,
+		localizer:        localizer,
+		dates:            dates,
+		horizon:          horizon,
 	}
 }
 
-func (b *Builder) Build(contacts []model.Contact) *fyne.Container {
-	birthdayBoysSurnames := make([]string, 0)
-	for _, contact := range contacts {
-		now := time.Now()
-
-		if contact.Birthday.Month() != now.Month() {
-			continue
-		}
+// Build – панель с ближайшими днями рождения и переключателем "7 / 30 дней"
+func (b *Builder) Build() *fyne.Container {
+	warningImage := canvas.NewImageFromResource(resources.WarningIcon)
+	warningImage.FillMode = canvas.ImageFillContain
+	warningImage.SetMinSize(iconSize)
+
+	infoText := widget.NewLabelWithStyle(b.localizer.T("birthday.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+
+	// Текст, который показывается вместо пустого списка или при ошибке
+	emptyText := widget.NewLabel("")
+	emptyText.Hide()
+
+	var upcoming []model.UpcomingBirthday
+
+	list := widget.NewList(
+		func() int {
+			return len(upcoming)
+		},
+		func() fyne.CanvasObject {
+			return widget.NewLabel("")
+		},
+		func(id int, obj fyne.CanvasObject) {
+			obj.(*widget.Label).SetText(b.present(upcoming[id]))
+		},
+	)
 
-		if contact.Birthday.Day() != now.Day() {
-			continue
-		}
+	// Прозрачная подложка задает минимальную высоту списка
+	listMinSize := canvas.NewRectangle(color.Transparent)
+	listMinSize.SetMinSize(fyne.NewSize(0, listMinHeight))
 
-		birthdayBoysSurnames = append(birthdayBoysSurnames, contact.Surname)
+	// Горизонт из конфигурации добавляется к стандартным, если его среди них нет
+	horizons := slices.Clone(defaultHorizons)
+	if !slices.Contains(horizons, b.horizon) {
+		horizons = append(horizons, b.horizon)
+		slices.Sort(horizons)
 	}
 
-	if len(birthdayBoysSurnames) == 0 {
-		return container.NewWithoutLayout()
+	// Подпись переключателя зависит от языка, поэтому горизонт ищем по ней
+	labels := make([]string, 0, len(horizons))
+	daysByLabel := make(map[string]int, len(horizons))
+	for _, days := range horizons {
+		label := b.localizer.Plural("birthday.horizon", days, nil)
+		labels = append(labels, label)
+		daysByLabel[label] = days
 	}
 
-	rectangle := canvas.NewRectangle(color.RGBA{R: 186, G: 209, B: 236, A: 255})
-	rectangle.CornerRadius = 10
-	rectangle.Resize(rectangleSize)
-
-	warningIcon, err := fyne.LoadResourceFromPath("./ui/icons/warning.png")
-	if err != nil {
-		panic(err)
-	}
-	warningImage := canvas.NewImageFromResource(warningIcon)
-	warningImage.Resize(fyne.NewSize(rectangleSize.Height-20, rectangleSize.Height-20))
-	warningImage.Move(fyne.NewPos(20, rectangleSize.Height/2-warningImage.Size().Height/2))
+	horizonRadio := widget.NewRadioGroup(labels, func(selected string) {
+		days, ok := daysByLabel[selected]
+		if !ok {
+			days = b.horizon
+		}
 
-	infoText := canvas.NewText("Сегодня день рождения:", color.Black)
-	infoText.TextSize = 16
+		var err error
+		upcoming, err = b.birthdaysHandler.Upcoming(context.Background(), days)
+		if err != nil {
+			b.reporter.Log("birthdays.load", err, "days", days)
 
-	birthdayBoysText := canvas.NewText(strings.Join(birthdayBoysSurnames, ", "), color.Black)
-	birthdayBoysText.TextSize = 16
+			upcoming = nil
+			emptyText.SetText(b.localizer.T("birthday.error"))
+		} else {
+			emptyText.SetText(b.localizer.T("birthday.empty"))
+		}
 
-	lines := container.NewVBox(infoText, birthdayBoysText)
-	lines.Move(fyne.NewPos(warningImage.Position().X+warningImage.Size().Width+10, rectangleSize.Height/2-lines.MinSize().Height/2))
+		if len(upcoming) > 0 {
+			emptyText.Hide()
+		} else {
+			emptyText.Show()
+		}
+		list.Refresh()
+	})
+	horizonRadio.Horizontal = true
+	horizonRadio.Required = true
+	horizonRadio.SetSelected(b.localizer.Plural("birthday.horizon", b.horizon, nil))
 
-	box := container.NewWithoutLayout(
-		rectangle,
-		lines,
+	header := container.NewHBox(
 		warningImage,
+		container.NewVBox(infoText, horizonRadio),
 	)
 
-	return box
+	content := container.NewBorder(
+		header,
+		nil,
+		nil,
+		nil,
+		container.NewStack(listMinSize, list, emptyText),
+	)
+
+	return container.NewStack(panel.NewBackground(), container.NewPadded(content))
+}
+
+// present – строка вида "Ершов Виталий, 10.01 – сегодня, исполнится 24"
+func (b *Builder) present(birthday model.UpcomingBirthday) string {
+	when := b.localizer.Plural("birthday.in", birthday.DaysLeft, nil)
+	switch birthday.DaysLeft {
+	case 0:
+		when = b.localizer.T("birthday.today")
+	case 1:
+		when = b.localizer.T("birthday.tomorrow")
+	}
+
+	params := map[string]any{
+		"Surname": birthday.Contact.Surname,
+		"Name":    birthday.Contact.Name,
+		"Date":    b.dates.FormatShort(birthday.Date),
+		"When":    when,
+		"Age":     birthday.Age,
+	}
+
+	// Год рождения неизвестен – возраст не показываем
+	if birthday.Age == 0 {
+		return b.localizer.Format("birthday.row.noage", params)
+	}
+
+	return b.localizer.Format("birthday.row", params)
 }
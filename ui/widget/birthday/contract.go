@@ -0,0 +1,26 @@
+package birthday
+
+import (
+	"context"
+	"time"
+
+	"contacts/internal/model"
+)
+
+type birthdaysHandler interface {
+	Upcoming(ctx context.Context, days int) ([]model.UpcomingBirthday, error)
+}
+
+type reporter interface {
+	Log(operation string, err error, attrs ...any)
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+	Plural(id string, count int, params map[string]any) string
+}
+
+type dates interface {
+	FormatShort(t time.Time) string
+}
@@ -1,178 +1,243 @@
 package contact_info
 
 import (
-	"image/color"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
 	expWidget "fyne.io/x/fyne/widget"
 
+	"contacts/internal/domain/date"
+	"contacts/internal/model"
 	"contacts/ui/dto"
+	"contacts/ui/resources"
 )
 
 var (
-	labelBoxSize           = fyne.NewSize(200, 30)
-	textEntryBoxSize       = fyne.NewSize(400, 30)
-	datePickerEntryBoxSize = fyne.NewSize(90, 30)
-	datePickerButtonSize   = fyne.NewSize(30, 30)
-	calendarSize           = fyne.NewSize(225, 200)
-	calendarBackgroundSize = fyne.NewSize(calendarSize.Width+10, calendarSize.Height+10)
+	avatarSize   = fyne.NewSize(90, 90)
+	calendarSize = fyne.NewSize(225, 200)
 )
 
+// Сколько строк заметок видно в режиме редактирования
+const notesRows = 5
+
 type Builder struct {
-	firstRowPosition   dto.Position
-	spacingBetweenRows float32
+	localizer localizer
+	dates     dates
 }
 
 func NewBuilder(
-	firstRowPosition dto.Position,
-	spacingBetweenRows float32,
+	localizer localizer,
+	dates dates,
 ) *Builder {
 	return &Builder{
-		firstRowPosition:   firstRowPosition,
-		spacingBetweenRows: spacingBetweenRows,
+		localizer: localizer,
+		dates:     dates,
 	}
 }
 
-func (w *Builder) Build(rowsData []dto.ContactInfoWidgetRowData) dto.ContactInfoWidget {
-	box := container.NewWithoutLayout()
-
-	currentPosY := w.firstRowPosition.Y
+// Build – форма с подписями слева и значениями справа.
+//
+// editing – открыть сразу в режиме редактирования, иначе значения только для просмотра.
+// Ширина полей подстраивается под окно, высота строк – под содержимое.
+func (w *Builder) Build(rowsData []dto.ContactInfoWidgetRowData, editing bool) dto.ContactInfoWidget {
+	box := container.New(layout.NewFormLayout())
 
 	// Для того, чтобы связать созданный label и entry
-	assignedByLabel := make(map[string]dto.ContactWidgetRow, len(rowsData))
+	assignedByField := make(map[model.Field]dto.ContactWidgetRow, len(rowsData))
 
-	// Выношу из цикла, т.к. компонент должен быть поверх всех остальных
-	var (
-		calendars           []*expWidget.Calendar
-		calendarBackgrounds []*canvas.Rectangle
-	)
+	// Переключатели режима для каждой строки
+	modeSwitches := make([]func(editing bool), 0, len(rowsData))
 
 	for _, rowData := range rowsData {
 		label := widget.NewLabel(rowData.Label + ":")
 		label.Alignment = fyne.TextAlignTrailing
 
-		// Контейнер для текста
-		labelBox := container.NewVBox(label)
-		labelBox.Resize(labelBoxSize)
-		labelBox.Move(fyne.NewPos(w.firstRowPosition.X, currentPosY))
+		// Ошибка проверки под полем, видна только в режиме редактирования
+		errorLabel := widget.NewLabel("")
+		errorLabel.Importance = widget.DangerImportance
+		errorLabel.Wrapping = fyne.TextWrapWord
+		errorLabel.Hide()
 
-		box.Add(labelBox)
+		entry, entryObject := w.buildEntry(rowData.Entry)
 
-		var entry *widget.Entry
+		var (
+			image        *canvas.Image
+			avatarButton *widget.Button
+			content      fyne.CanvasObject
+			setEditing   func(editing bool)
+		)
 
 		switch rowData.Entry.Type {
-		case dto.ContactWidgetRowTypeText:
-			entry = w.buildEntry(rowData.Entry)
-
-			entryBox := container.NewVBox(entry)
-			entryBox.Resize(textEntryBoxSize)
-			entryBox.Move(fyne.NewPos(w.firstRowPosition.X+labelBoxSize.Width, currentPosY))
-
-			box.Add(entryBox)
-		case dto.ContactWidgetRowTypeDatePicker:
-			entry = w.buildEntry(rowData.Entry)
-
-			entryBox := container.NewVBox(entry)
-			entryBox.Resize(datePickerEntryBoxSize)
-			entryBox.Move(fyne.NewPos(w.firstRowPosition.X+labelBoxSize.Width, currentPosY))
-
-			var (
-				calendar           *expWidget.Calendar
-				calendarBackground *canvas.Rectangle
-			)
-
-			currentTime := time.Now()
-			if rowData.Entry.Value != nil {
-				t, err := time.Parse("02.01.2006", *rowData.Entry.Value)
-				if err == nil {
-					currentTime = t
+		case dto.ContactWidgetRowTypeAvatar:
+			// Хэш фото храним в скрытом поле, чтобы окна читали его так же, как остальные значения
+			entry.Hide()
+
+			image = canvas.NewImageFromResource(rowData.Entry.Image)
+			image.FillMode = canvas.ImageFillContain
+			image.SetMinSize(avatarSize)
+
+			// Обработчик нажатия задает окно, т.к. для выбора файла нужен родительский window
+			avatarButton = widget.NewButton(w.localizer.T("contact.photo.pick"), nil)
+
+			content = container.NewHBox(image, entry, container.NewCenter(avatarButton))
+			setEditing = func(editing bool) {
+				avatarButton.Hidden = !editing
+				avatarButton.Refresh()
+			}
+		case dto.ContactWidgetRowTypeNotes:
+			view := newNotesView()
+
+			content = container.NewVBox(container.NewStack(view.box, entryObject), errorLabel)
+			setEditing = func(editing bool) {
+				if editing {
+					view.box.Hide()
+					entryObject.Show()
+					return
 				}
+
+				view.set(entry.Text)
+				view.box.Show()
+				entryObject.Hide()
+				errorLabel.Hide()
 			}
+		default:
+			view := newValueView(rowData.Entry.Type)
 
-			calendar = expWidget.NewCalendar(currentTime, func(t time.Time) {
-				entry.SetText(t.Format("02.01.2006"))
-				// После выбора даты скрываем календарь и подложку
-				calendar.Hide()
-				calendarBackground.Hide()
-			})
-			calendar.Resize(calendarSize)
-			calendar.Hide()
-
-			// Достаем иконку календаря
-			datePickerIcon, err := fyne.LoadResourceFromPath("./ui/icons/datepicker.png")
-			if err != nil {
-				panic(err)
+			edit := entryObject
+			if rowData.Entry.Type == dto.ContactWidgetRowTypeDatePicker {
+				edit = w.buildDatePicker(entry, rowData.Entry)
 			}
 
-			// Создаем кнопку для открытия календаря
-			datePickerButton := widget.NewButtonWithIcon("", datePickerIcon, func() {
-				if !calendar.Hidden {
-					calendar.Hide()
-					calendarBackground.Hide()
+			content = container.NewVBox(container.NewStack(view.box, edit), errorLabel)
+			setEditing = func(editing bool) {
+				if editing {
+					view.box.Hide()
+					edit.Show()
 					return
 				}
-				calendar.Show()
-				calendarBackground.Show()
-			})
-			datePickerButton.Resize(datePickerButtonSize)
-			datePickButtonPos := fyne.NewPos(
-				w.firstRowPosition.X+labelBoxSize.Width+datePickerEntryBoxSize.Width+5,
-				currentPosY+2,
-			)
-			datePickerButton.Move(datePickButtonPos)
-
-			// Подложка под календарь
-			calendarBackground = canvas.NewRectangle(color.White)
-			calendarBackground.CornerRadius = 10
-			calendarBackground.Resize(calendarBackgroundSize)
-			calendarBackground.Hide() // По умолчанию скрыт
-
-			// Позиционирование календаря
-			calendarPos := fyne.NewPos(
-				datePickerButton.Position().X+50,
-				currentPosY-2*w.spacingBetweenRows+10,
-			)
-			calendar.Move(fyne.NewPos(calendarPos.X+5, calendarPos.Y))
-			calendarBackground.Move(calendarPos)
-
-			box.Add(entryBox)
-			box.Add(datePickerButton)
-
-			// Т.к. календарей может быть несколько, то добавляем их в массив
-			calendars = append(calendars, calendar)
-			calendarBackgrounds = append(calendarBackgrounds, calendarBackground)
+
+				// В просмотре показываем то, что сейчас в форме
+				view.set(entry.Text)
+				view.box.Show()
+				edit.Hide()
+				errorLabel.Hide()
+			}
+		}
+
+		// У фото нет проверки, поэтому нет и сообщения под ним
+		if rowData.Entry.Type == dto.ContactWidgetRowTypeAvatar {
+			errorLabel = nil
 		}
 
-		currentPosY += w.spacingBetweenRows
+		box.Add(label)
+		box.Add(content)
+
+		modeSwitches = append(modeSwitches, setEditing)
 
-		assignedByLabel[rowData.Label] = dto.ContactWidgetRow{
-			Label: label,
-			Entry: entry,
+		assignedByField[rowData.Field] = dto.ContactWidgetRow{
+			Label:  label,
+			Entry:  entry,
+			Error:  errorLabel,
+			Image:  image,
+			Button: avatarButton,
 		}
 	}
 
-	// Вынесено сюда, чтобы компоненты были поверх полей ввода
-	for i := 0; i < len(calendars); i++ {
-		box.Add(calendarBackgrounds[i])
-		box.Add(calendars[i])
+	setEditing := func(editing bool) {
+		for _, modeSwitch := range modeSwitches {
+			modeSwitch(editing)
+		}
 	}
+	setEditing(editing)
 
 	return dto.ContactInfoWidget{
-		AssignedByLabel: assignedByLabel,
+		AssignedByField: assignedByField,
 		Box:             box,
-		Size: &fyne.Size{
-			Width:  labelBoxSize.Width + textEntryBoxSize.Width,
-			Height: currentPosY,
-		},
+		SetEditing:      setEditing,
 	}
 }
 
-func (w *Builder) buildEntry(entryDto dto.ContactInfoWidgetRowEntry) *widget.Entry {
+// buildDatePicker – поле с датой и кнопка, открывающая календарь поверх окна
+func (w *Builder) buildDatePicker(entry *widget.Entry, entryDto dto.ContactInfoWidgetRowEntry) fyne.CanvasObject {
+	currentTime := time.Now()
+	if entryDto.Value != nil {
+		t, err := w.dates.Parse(*entryDto.Value)
+		if err == nil {
+			currentTime = t
+		}
+
+		// Для даты без года календарь открываем на текущем году
+		if err == nil && !date.HasYear(t) {
+			currentTime = time.Date(time.Now().Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+		}
+	}
+
+	var popUp *widget.PopUp
+
+	calendar := expWidget.NewCalendar(currentTime, func(t time.Time) {
+		entry.SetText(w.dates.Format(t))
+		// После выбора даты скрываем календарь
+		popUp.Hide()
+	})
+
+	var datePickerButton *widget.Button
+
+	// Кнопка для открытия календаря
+	datePickerButton = widget.NewButtonWithIcon("", resources.DatePickerIcon, func() {
+		if popUp == nil {
+			canvas := fyne.CurrentApp().Driver().CanvasForObject(datePickerButton)
+			popUp = widget.NewPopUp(calendar, canvas)
+			popUp.Resize(calendarSize)
+		}
+
+		popUp.ShowAtRelativePosition(fyne.NewPos(datePickerButton.Size().Width, 0), datePickerButton)
+	})
+
+	return container.NewBorder(nil, nil, nil, datePickerButton, entry)
+}
+
+// buildEntry – поле ввода и объект, который добавляется в форму.
+//
+// Для телефона это поле с маской, для организации – поле со списком,
+// окна читают их значение через тот же *widget.Entry.
+func (w *Builder) buildEntry(entryDto dto.ContactInfoWidgetRowEntry) (*widget.Entry, fyne.CanvasObject) {
+	if entryDto.Type == dto.ContactWidgetRowTypePhone {
+		entry := newPhoneEntry()
+
+		if entryDto.Value != nil {
+			entry.SetValue(*entryDto.Value)
+		}
+		if entryDto.Placeholder != nil {
+			entry.SetPlaceHolder(*entryDto.Placeholder)
+		}
+
+		return &entry.Entry, entry
+	}
+
+	// Организацию выбирают из существующих или вводят новую
+	if entryDto.Type == dto.ContactWidgetRowTypeOrganization {
+		entry := widget.NewSelectEntry(entryDto.Options)
+
+		if entryDto.Value != nil {
+			entry.SetText(*entryDto.Value)
+		}
+		if entryDto.Placeholder != nil {
+			entry.SetPlaceHolder(*entryDto.Placeholder)
+		}
+
+		return &entry.Entry, entry
+	}
+
 	entry := widget.NewEntry()
+	if entryDto.Type == dto.ContactWidgetRowTypeNotes {
+		entry = widget.NewMultiLineEntry()
+		entry.Wrapping = fyne.TextWrapWord
+		entry.SetMinRowsVisible(notesRows)
+	}
 
 	// Подставляем текст в форму
 	if entryDto.Value != nil {
@@ -184,13 +249,5 @@ func (w *Builder) buildEntry(entryDto dto.ContactInfoWidgetRowEntry) *widget.Ent
 		entry.SetPlaceHolder(*entryDto.Placeholder)
 	}
 
-	// Для опции DisableEdit запрещаем редактировать форму
-	if entryDto.DisableEdit {
-		text := entry.Text
-		entry.OnChanged = func(_ string) {
-			entry.SetText(text)
-		}
-	}
-
-	return entry
+	return entry, entry
 }
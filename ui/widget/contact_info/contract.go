@@ -0,0 +1,13 @@
+package contact_info
+
+import "time"
+
+type localizer interface {
+	T(id string) string
+}
+
+type dates interface {
+	Parse(value string) (time.Time, error)
+	Format(t time.Time) string
+	FormatDateTime(t time.Time) string
+}
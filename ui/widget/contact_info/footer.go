@@ -0,0 +1,47 @@
+package contact_info
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+)
+
+// Разделитель значений в подвале карточки
+const footerSeparator = " · "
+
+// BuildFooter – подвал карточки: когда контакт добавлен и изменен и откуда он появился.
+//
+// Неизвестные значения пропускаются, если неизвестно ничего – подвал пустой.
+func (w *Builder) BuildFooter(contact model.Contact) fyne.CanvasObject {
+	parts := make([]string, 0, 3)
+
+	if !contact.CreatedAt.IsZero() {
+		parts = append(parts, w.localizer.T("contact.meta.created")+": "+w.dates.FormatDateTime(contact.CreatedAt.Local()))
+	}
+
+	// Дату изменения показываем, только если контакт меняли после создания
+	if !contact.UpdatedAt.IsZero() && !contact.UpdatedAt.Equal(contact.CreatedAt) {
+		parts = append(parts, w.localizer.T("contact.meta.updated")+": "+w.dates.FormatDateTime(contact.UpdatedAt.Local()))
+	}
+
+	if contact.Source != "" {
+		parts = append(parts, w.localizer.T("contact.meta.source")+": "+w.localizer.T("source."+string(contact.Source)))
+	}
+
+	// Мелкий приглушенный шрифт, чтобы подвал не спорил с полями контакта
+	style := widget.RichTextStyleParagraph
+	style.ColorName = theme.ColorNamePlaceHolder
+	style.SizeName = theme.SizeNameCaptionText
+
+	footer := widget.NewRichText(&widget.TextSegment{
+		Text:  strings.Join(parts, footerSeparator),
+		Style: style,
+	})
+	footer.Wrapping = fyne.TextWrapWord
+
+	return footer
+}
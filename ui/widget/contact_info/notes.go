@@ -0,0 +1,43 @@
+package contact_info
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// notesView – заметки в режиме просмотра, Markdown показывается с оформлением
+type notesView struct {
+	markdown *widget.RichText
+	empty    *widget.Label
+
+	box *fyne.Container
+}
+
+func newNotesView() *notesView {
+	v := &notesView{
+		markdown: widget.NewRichText(),
+		empty:    widget.NewLabel(emptyValue),
+	}
+
+	v.markdown.Wrapping = fyne.TextWrapWord
+
+	v.box = container.NewStack(v.markdown, v.empty)
+
+	return v
+}
+
+// set – показать новые заметки
+func (v *notesView) set(value string) {
+	if strings.TrimSpace(value) == "" {
+		v.empty.Show()
+		v.markdown.Hide()
+		return
+	}
+
+	v.markdown.ParseMarkdown(value)
+	v.markdown.Show()
+	v.empty.Hide()
+}
@@ -0,0 +1,52 @@
+package contact_info
+
+import (
+	"contacts/internal/model"
+	"contacts/ui/dto"
+	"contacts/util/pointer"
+)
+
+// OrganizationRows – строки формы с местом работы: организация, отдел и должность.
+//
+// organizations – все организации в порядке показа, контакт ссылается на одну из них по UUID.
+// Окна находят организацию по введенному названию или создают новую.
+func (w *Builder) OrganizationRows(organizations []model.Organization, contact model.Contact) []dto.ContactInfoWidgetRowData {
+	var name string
+	options := make([]string, 0, len(organizations))
+	for _, organization := range organizations {
+		options = append(options, organization.Name)
+
+		if organization.UUID == contact.OrganizationUUID {
+			name = organization.Name
+		}
+	}
+
+	return []dto.ContactInfoWidgetRowData{
+		{
+			Field: model.FieldOrganization,
+			Label: w.localizer.T("field.organization"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Type:        dto.ContactWidgetRowTypeOrganization,
+				Value:       &name,
+				Placeholder: pointer.To(w.localizer.T("placeholder.organization")),
+				Options:     options,
+			},
+		},
+		{
+			Field: model.FieldDepartment,
+			Label: w.localizer.T("field.department"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Type:  dto.ContactWidgetRowTypeText,
+				Value: pointer.To(contact.Department),
+			},
+		},
+		{
+			Field: model.FieldTitle,
+			Label: w.localizer.T("field.title"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Type:  dto.ContactWidgetRowTypeText,
+				Value: pointer.To(contact.Title),
+			},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package contact_info
+
+import (
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/domain/phone"
+)
+
+// phoneEntry – поле телефона, которое само расставляет цифры по маске +7 (915) 159-67-81.
+//
+// Принимает вставку номера в любом распространенном виде,
+// курсор после переформатирования остается рядом с той же цифрой.
+type phoneEntry struct {
+	widget.Entry
+}
+
+func newPhoneEntry() *phoneEntry {
+	e := &phoneEntry{}
+	e.Wrapping = fyne.TextWrap(fyne.TextTruncateClip)
+	e.ExtendBaseWidget(e)
+
+	return e
+}
+
+// SetValue – показать номер, записанный в любом виде
+func (e *phoneEntry) SetValue(value string) {
+	digits := phone.Digits(value)
+	e.render(digits, len(digits), false)
+}
+
+func (e *phoneEntry) TypedRune(r rune) {
+	// Буквы и знаки маска расставляет сама
+	if !unicode.IsDigit(r) {
+		return
+	}
+
+	// Первая 7 или 8 – это код страны, показываем начало маски
+	if e.Text == "" && (r == '7' || r == '8') {
+		e.render("", 0, true)
+		return
+	}
+
+	digits := phone.Digits(e.Text)
+	if len(digits) >= phone.NationalLength {
+		return
+	}
+
+	at := e.cursorDigits()
+	e.render(digits[:at]+string(r)+digits[at:], at+1, false)
+}
+
+func (e *phoneEntry) TypedKey(key *fyne.KeyEvent) {
+	digits := phone.Digits(e.Text)
+	at := e.cursorDigits()
+
+	switch key.Name {
+	case fyne.KeyBackspace:
+		if at == 0 {
+			// Осталось только "+7 (" – очищаем поле целиком
+			if digits == "" {
+				e.render("", 0, false)
+			}
+			return
+		}
+
+		e.render(digits[:at-1]+digits[at:], at-1, false)
+	case fyne.KeyDelete:
+		if at >= len(digits) {
+			return
+		}
+
+		e.render(digits[:at]+digits[at+1:], at, false)
+	default:
+		e.Entry.TypedKey(key)
+	}
+}
+
+func (e *phoneEntry) TypedShortcut(shortcut fyne.Shortcut) {
+	paste, ok := shortcut.(*fyne.ShortcutPaste)
+	if !ok {
+		e.Entry.TypedShortcut(shortcut)
+		// Вырезание могло нарушить маску
+		e.normalize()
+		return
+	}
+
+	pasted := phone.Digits(paste.Clipboard.Content())
+
+	// Полный номер заменяет введенный, часть номера вставляется у курсора
+	if len(pasted) == phone.NationalLength {
+		e.render(pasted, len(pasted), false)
+		return
+	}
+
+	digits := phone.Digits(e.Text)
+	at := e.cursorDigits()
+
+	digits = digits[:at] + pasted + digits[at:]
+	if len(digits) > phone.NationalLength {
+		digits = digits[:phone.NationalLength]
+	}
+
+	e.render(digits, min(at+len(pasted), len(digits)), false)
+}
+
+func (e *phoneEntry) FocusLost() {
+	// Текст мог измениться в обход маски, например через контекстное меню
+	e.normalize()
+	e.Entry.FocusLost()
+}
+
+func (e *phoneEntry) normalize() {
+	digits := phone.Digits(e.Text)
+	if phone.Format(digits) == e.Text {
+		return
+	}
+
+	e.render(digits, min(e.cursorDigits(), len(digits)), false)
+}
+
+// cursorDigits – сколько цифр номера стоит перед курсором
+func (e *phoneEntry) cursorDigits() int {
+	return phone.Count(e.Text, e.CursorColumn)
+}
+
+// render – показать цифры по маске и поставить курсор после cursor цифр.
+//
+// keepPrefix – оставить "+7 (", даже если цифр еще нет.
+func (e *phoneEntry) render(digits string, cursor int, keepPrefix bool) {
+	text := phone.Format(digits)
+	if text == "" && keepPrefix {
+		text = phone.Prefix + " ("
+	}
+
+	e.SetText(text)
+	e.CursorColumn = phone.Position(text, cursor)
+	e.Refresh()
+}
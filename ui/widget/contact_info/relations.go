@@ -0,0 +1,58 @@
+package contact_info
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+)
+
+// BuildRelations – связи контакта строками "кем приходится: имя".
+//
+// Щелчок по имени вызывает open с UUID связанного контакта.
+// remove == nil – связи только для просмотра, без кнопок удаления.
+func (w *Builder) BuildRelations(
+	related []model.RelatedContact,
+	open func(uuid string),
+	remove func(related model.RelatedContact),
+) fyne.CanvasObject {
+	box := container.New(layout.NewFormLayout())
+
+	for _, relatedContact := range related {
+		relatedContact := relatedContact
+
+		label := widget.NewLabel(w.localizer.T("relation."+string(relatedContact.Type)) + ":")
+		label.Alignment = fyne.TextAlignTrailing
+
+		name := strings.TrimSpace(relatedContact.Contact.Surname + " " + relatedContact.Contact.Name)
+		if name == "" {
+			name = emptyValue
+		}
+
+		link := widget.NewHyperlink(name, nil)
+		link.Truncation = fyne.TextTruncateEllipsis
+		link.OnTapped = func() {
+			open(relatedContact.Contact.UUID)
+		}
+
+		var value fyne.CanvasObject = link
+		if remove != nil {
+			removeButton := widget.NewButtonWithIcon("", theme.ContentClearIcon(), func() {
+				remove(relatedContact)
+			})
+			removeButton.Importance = widget.LowImportance
+
+			value = container.NewBorder(nil, nil, nil, removeButton, link)
+		}
+
+		box.Add(label)
+		box.Add(value)
+	}
+
+	return box
+}
@@ -0,0 +1,126 @@
+package contact_info
+
+import (
+	"net/url"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/ui/dto"
+	"contacts/ui/widget/parent"
+)
+
+// Текст вместо пустого значения в режиме просмотра
+const emptyValue = "—"
+
+// valueView – представление значения в режиме просмотра.
+//
+// Телефон, email и ссылки открываются по клику, любое значение можно скопировать.
+type valueView struct {
+	rowType dto.ContactWidgetRowType
+
+	label      *widget.Label
+	hyperlink  *widget.Hyperlink
+	copyButton *widget.Button
+
+	box   *fyne.Container
+	value string
+}
+
+func newValueView(rowType dto.ContactWidgetRowType) *valueView {
+	v := &valueView{
+		rowType:   rowType,
+		label:     widget.NewLabel(""),
+		hyperlink: widget.NewHyperlink("", nil),
+	}
+
+	v.label.Truncation = fyne.TextTruncateEllipsis
+	v.hyperlink.Truncation = fyne.TextTruncateEllipsis
+
+	v.copyButton = widget.NewButtonWithIcon("", theme.ContentCopyIcon(), v.copy)
+	v.copyButton.Importance = widget.LowImportance
+
+	v.box = container.NewBorder(nil, nil, nil, v.copyButton, container.NewStack(v.label, v.hyperlink))
+
+	return v
+}
+
+// set – показать новое значение
+func (v *valueView) set(value string) {
+	v.value = strings.TrimSpace(value)
+
+	if v.value == "" {
+		v.label.SetText(emptyValue)
+		v.label.Show()
+		v.hyperlink.Hide()
+		v.copyButton.Hide()
+		return
+	}
+
+	v.copyButton.Show()
+
+	target := v.target()
+	if target == nil {
+		v.label.SetText(v.value)
+		v.label.Show()
+		v.hyperlink.Hide()
+		return
+	}
+
+	v.hyperlink.SetText(v.value)
+	v.hyperlink.SetURL(target)
+	v.hyperlink.Show()
+	v.label.Hide()
+}
+
+// target – куда ведет клик по значению, nil – значение не ссылка
+func (v *valueView) target() *url.URL {
+	var raw string
+
+	switch v.rowType {
+	case dto.ContactWidgetRowTypePhone:
+		raw = "tel:" + phoneDigits(v.value)
+	case dto.ContactWidgetRowTypeEmail:
+		raw = "mailto:" + v.value
+	case dto.ContactWidgetRowTypeLink:
+		raw = v.value
+		// Ссылку без схемы открываем как https
+		if !strings.Contains(raw, "://") {
+			raw = "https://" + raw
+		}
+	default:
+		return nil
+	}
+
+	target, err := url.Parse(raw)
+	if err != nil {
+		return nil
+	}
+
+	return target
+}
+
+// copy – копирует значение в буфер обмена окна, в котором находится кнопка
+func (v *valueView) copy() {
+	window := parent.Window(v.copyButton)
+	if window == nil {
+		return
+	}
+
+	window.Clipboard().SetContent(v.value)
+}
+
+// phoneDigits – номер для ссылки tel: без пробелов, скобок и дефисов
+func phoneDigits(phone string) string {
+	var b strings.Builder
+	for _, r := range phone {
+		if r == '+' || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,164 @@
+package contacts_list
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Прозрачность акцентного цвета в подложке инициалов
+const initialsAlpha = 0x60
+
+// badge – короткая надпись на скругленной подложке в акцентном цвете темы.
+//
+// Цвета читаются при каждой перерисовке, поэтому значок следует за сменой темы.
+type badge struct {
+	widget.BaseWidget
+
+	text string
+}
+
+func newBadge() *badge {
+	b := &badge{}
+	b.ExtendBaseWidget(b)
+
+	return b
+}
+
+// SetText – изменить надпись, пустая строка прячет значок
+func (b *badge) SetText(text string) {
+	b.text = text
+	if text == "" {
+		b.Hide()
+	} else {
+		b.Show()
+	}
+	b.Refresh()
+}
+
+func (b *badge) CreateRenderer() fyne.WidgetRenderer {
+	r := &badgeRenderer{
+		badge:      b,
+		background: canvas.NewRectangle(color.Transparent),
+		text:       canvas.NewText("", color.Transparent),
+	}
+	r.Refresh()
+
+	return r
+}
+
+type badgeRenderer struct {
+	badge      *badge
+	background *canvas.Rectangle
+	text       *canvas.Text
+}
+
+func (r *badgeRenderer) Layout(size fyne.Size) {
+	r.background.Resize(size)
+
+	textSize := r.text.MinSize()
+	r.text.Move(fyne.NewPos((size.Width-textSize.Width)/2, (size.Height-textSize.Height)/2))
+	r.text.Resize(textSize)
+}
+
+func (r *badgeRenderer) MinSize() fyne.Size {
+	padding := theme.InnerPadding()
+
+	return r.text.MinSize().AddWidthHeight(padding, padding/2)
+}
+
+func (r *badgeRenderer) Refresh() {
+	r.background.FillColor = theme.Color(theme.ColorNamePrimary)
+	r.background.CornerRadius = theme.InputRadiusSize() * 2
+	r.background.Refresh()
+
+	r.text.Text = r.badge.text
+	r.text.Color = theme.Color(theme.ColorNameForegroundOnPrimary)
+	r.text.TextSize = theme.CaptionTextSize()
+	r.text.Refresh()
+
+	r.Layout(r.badge.Size())
+}
+
+func (r *badgeRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.background, r.text}
+}
+
+func (r *badgeRenderer) Destroy() {}
+
+// initials – круг с первыми буквами фамилии и имени для контакта без фото
+type initials struct {
+	widget.BaseWidget
+
+	text string
+	size fyne.Size
+}
+
+func newInitials(size fyne.Size) *initials {
+	i := &initials{size: size}
+	i.ExtendBaseWidget(i)
+
+	return i
+}
+
+func (i *initials) SetText(text string) {
+	i.text = text
+	i.Refresh()
+}
+
+func (i *initials) CreateRenderer() fyne.WidgetRenderer {
+	r := &initialsRenderer{
+		initials: i,
+		circle:   canvas.NewCircle(color.Transparent),
+		text:     canvas.NewText("", color.Transparent),
+	}
+	r.text.TextStyle = fyne.TextStyle{Bold: true}
+	r.Refresh()
+
+	return r
+}
+
+type initialsRenderer struct {
+	initials *initials
+	circle   *canvas.Circle
+	text     *canvas.Text
+}
+
+func (r *initialsRenderer) Layout(size fyne.Size) {
+	side := fyne.Min(size.Width, size.Height)
+	offset := fyne.NewPos((size.Width-side)/2, (size.Height-side)/2)
+
+	r.circle.Move(offset)
+	r.circle.Resize(fyne.NewSquareSize(side))
+
+	textSize := r.text.MinSize()
+	r.text.Move(fyne.NewPos((size.Width-textSize.Width)/2, (size.Height-textSize.Height)/2))
+	r.text.Resize(textSize)
+}
+
+func (r *initialsRenderer) MinSize() fyne.Size {
+	return r.initials.size
+}
+
+func (r *initialsRenderer) Refresh() {
+	red, green, blue, _ := theme.Color(theme.ColorNamePrimary).RGBA()
+
+	r.circle.FillColor = color.NRGBA{R: uint8(red >> 8), G: uint8(green >> 8), B: uint8(blue >> 8), A: initialsAlpha}
+	r.circle.Refresh()
+
+	r.text.Text = r.initials.text
+	r.text.Color = theme.Color(theme.ColorNameForeground)
+	r.text.TextSize = theme.CaptionTextSize()
+	r.text.Refresh()
+
+	r.Layout(r.initials.Size())
+}
+
+func (r *initialsRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.circle, r.text}
+}
+
+func (r *initialsRenderer) Destroy() {}
@@ -0,0 +1,300 @@
+package contacts_list
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+	"contacts/ui/dto"
+	"contacts/ui/presenter/phone"
+	"contacts/ui/resources"
+	widgetContactInfo "contacts/ui/widget/contact_info"
+	errorWidget "contacts/ui/widget/error"
+	"contacts/ui/widget/parent"
+	"contacts/util/pointer"
+)
+
+// showContact – карточка выбранного контакта в режиме просмотра.
+//
+// Кнопка "Изменить" переключает карточку в форму на месте, "Отмена" возвращает исходные значения.
+func (b *Builder) showContact(id widget.ListItemID) {
+	contact, ok := b.contactAt(id)
+	if !ok {
+		// Заголовок группы выбрать нельзя
+		b.contactsList.Unselect(id)
+		return
+	}
+
+	b.selectedContact = &contact
+	b.selectedID = id
+
+	b.recent.Add(contact.UUID)
+
+	// Выбор одного контакта заменяет множественный выбор
+	b.selected = map[string]struct{}{contact.UUID: {}}
+	b.selectAll.SetChecked(b.allSelected())
+	b.contactsList.Refresh()
+
+	contactInfoWidgetBuilder := widgetContactInfo.NewBuilder(b.localizer, b.dates)
+
+	contactsWidgetRowsData := []dto.ContactInfoWidgetRowData{
+		{
+			Field: model.FieldAvatar,
+			Label: b.localizer.T("field.photo"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Value: &contact.Avatar,
+				Type:  dto.ContactWidgetRowTypeAvatar,
+				Image: b.avatarBuilder.Resource(contact.Avatar),
+			},
+		},
+		{
+			Field: model.FieldSurname,
+			Label: b.localizer.T("field.surname"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Value: &contact.Surname,
+				Type:  dto.ContactWidgetRowTypeText,
+			},
+		},
+		{
+			Field: model.FieldName,
+			Label: b.localizer.T("field.name"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Value: &contact.Name,
+				Type:  dto.ContactWidgetRowTypeText,
+			},
+		},
+		{
+			Field: model.FieldBirthday,
+			Label: b.localizer.T("field.birthday"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Value: pointer.To(b.dates.Format(contact.Birthday)),
+				Type:  dto.ContactWidgetRowTypeDatePicker,
+			},
+		},
+		{
+			Field: model.FieldPhone,
+			Label: b.localizer.T("field.phone"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Value: pointer.To(phone.Present(contact.Phone.Number())),
+				Type:  dto.ContactWidgetRowTypePhone,
+			},
+		},
+		{
+			Field: model.FieldEmail,
+			Label: b.localizer.T("field.email"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Value: &contact.Email,
+				Type:  dto.ContactWidgetRowTypeEmail,
+			},
+		},
+	}
+	contactsWidgetRowsData = append(contactsWidgetRowsData, contactInfoWidgetBuilder.OrganizationRows(b.organizations, contact)...)
+
+	for link, value := range contact.Links {
+		contactsWidgetRowsData = append(contactsWidgetRowsData, dto.ContactInfoWidgetRowData{
+			Field: model.Field(link),
+			Label: string(link),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Value: &value,
+				Type:  dto.ContactWidgetRowTypeLink,
+			},
+		})
+	}
+
+	// Заметки в конце карточки, после ссылок
+	contactsWidgetRowsData = append(contactsWidgetRowsData, dto.ContactInfoWidgetRowData{
+		Field: model.FieldNotes,
+		Label: b.localizer.T("field.notes"),
+		Entry: dto.ContactInfoWidgetRowEntry{
+			Value:       &contact.Notes,
+			Type:        dto.ContactWidgetRowTypeNotes,
+			Placeholder: pointer.To(b.localizer.T("placeholder.notes")),
+		},
+	})
+
+	contactInfoWidget := contactInfoWidgetBuilder.Build(contactsWidgetRowsData, false)
+
+	// Выбор фото, окно для диалога ищем по кнопке
+	avatarRow := contactInfoWidget.AssignedByField[model.FieldAvatar]
+	avatarRow.Button.OnTapped = func() {
+		window := parent.Window(avatarRow.Button)
+		if window == nil {
+			return
+		}
+
+		b.avatarBuilder.Pick(window, func(hash string) {
+			avatarRow.Entry.SetText(hash)
+			avatarRow.Image.Resource = b.avatarBuilder.Resource(hash)
+			avatarRow.Image.Refresh()
+		})
+	}
+
+	// Ошибки, которые не относятся к конкретному полю
+	errorLabel := widget.NewLabel("")
+	errorLabel.Importance = widget.DangerImportance
+	errorLabel.Wrapping = fyne.TextWrapWord
+	errorLabel.Hide()
+
+	editButton := widget.NewButtonWithIcon(b.localizer.T("button.edit"), theme.DocumentCreateIcon(), nil)
+	saveButton := widget.NewButtonWithIcon(b.localizer.T("button.save"), theme.DocumentSaveIcon(), nil)
+	saveButton.Importance = widget.HighImportance
+	cancelButton := widget.NewButton(b.localizer.T("button.cancel"), func() {
+		// Перестраиваем карточку из сохраненного контакта
+		b.showContact(id)
+	})
+
+	setEditing := func(editing bool) {
+		contactInfoWidget.SetEditing(editing)
+
+		editButton.Hidden = editing
+		saveButton.Hidden = !editing
+		cancelButton.Hidden = !editing
+
+		editButton.Refresh()
+		saveButton.Refresh()
+		cancelButton.Refresh()
+	}
+
+	editButton.OnTapped = func() {
+		setEditing(true)
+	}
+	saveButton.OnTapped = func() {
+		b.save(contact, &contactInfoWidget, errorLabel, saveButton)
+	}
+
+	setEditing(false)
+
+	// Поля проверяются во время ввода, сохранить можно только корректную форму
+	errorWidget.Watch(&contactInfoWidget, b.validator, b.localizer, func(valid bool) {
+		if valid {
+			saveButton.Enable()
+		} else {
+			saveButton.Disable()
+		}
+	})
+
+	buttons := container.NewHBox(b.buildFavoriteButton(contact), layout.NewSpacer(), editButton, saveButton, cancelButton)
+
+	// Метки меняются только массовыми действиями, в карточке их только показываем
+	header := container.NewVBox(buttons)
+	if len(contact.Tags) > 0 {
+		tags := widget.NewLabel(b.localizer.T("field.tags") + ": " + strings.Join(contact.Tags, ", "))
+		tags.Wrapping = fyne.TextWrapWord
+		header.Add(tags)
+	}
+
+	b.contactInfoBox.Objects = []fyne.CanvasObject{
+		container.NewBorder(
+			header,
+			container.NewVBox(errorLabel, contactInfoWidgetBuilder.BuildFooter(contact)),
+			nil,
+			nil,
+			container.NewVBox(
+				contactInfoWidget.Box,
+				b.buildRelations(contact, contactInfoWidgetBuilder),
+				b.buildInteractions(contact),
+			),
+		),
+	}
+	b.contactInfoBox.Refresh()
+}
+
+// buildFavoriteButton – звезда, которая закрепляет контакт в начале списка или открепляет его
+func (b *Builder) buildFavoriteButton(contact model.Contact) *widget.Button {
+	icon := resources.NotFavoriteIcon
+	if contact.Favorite {
+		icon = resources.FavoriteIcon
+	}
+
+	var button *widget.Button
+	button = widget.NewButtonWithIcon("", icon, func() {
+		err := b.favoriteHandler.SetFavorite(context.Background(), contact.UUID, !contact.Favorite)
+		if err != nil {
+			b.reporter.Error(parent.Window(button), "contact.favorite", err, button.OnTapped, "uuid", contact.UUID)
+			return
+		}
+
+		// Контакт переезжает в закрепленные, список перестраивается
+		b.load()
+		b.selectByUUID(contact.UUID)
+	})
+	button.Importance = widget.LowImportance
+
+	return button
+}
+
+// save – сохраняет изменения из карточки и снова выбирает контакт в обновленном списке
+func (b *Builder) save(
+	contact model.Contact,
+	contactInfoWidget *dto.ContactInfoWidget,
+	errorLabel *widget.Label,
+	saveButton *widget.Button,
+) {
+	errorWidget.Clear(contactInfoWidget, errorLabel)
+
+	links := make(map[model.ContactLink]string, len(contact.Links))
+	for link := range contact.Links {
+		contactWidgetRow, ok := contactInfoWidget.AssignedByField[model.Field(link)]
+		if !ok {
+			continue
+		}
+		links[link] = contactWidgetRow.Entry.Text
+	}
+
+	fieldMsgs, err := b.updateHandler.Update(context.Background(), model.ContactForCreate{
+		UUID:     &contact.UUID,
+		Surname:  contactInfoWidget.AssignedByField[model.FieldSurname].Entry.Text,
+		Name:     contactInfoWidget.AssignedByField[model.FieldName].Entry.Text,
+		Birthday: contactInfoWidget.AssignedByField[model.FieldBirthday].Entry.Text,
+		Phone:    contactInfoWidget.AssignedByField[model.FieldPhone].Entry.Text,
+		Email:    contactInfoWidget.AssignedByField[model.FieldEmail].Entry.Text,
+		Links:    links,
+		Avatar:   contactInfoWidget.AssignedByField[model.FieldAvatar].Entry.Text,
+		Tags:     contact.Tags,
+		Favorite: contact.Favorite,
+		Notes:    contactInfoWidget.AssignedByField[model.FieldNotes].Entry.Text,
+
+		Organization: contactInfoWidget.AssignedByField[model.FieldOrganization].Entry.Text,
+		Department:   contactInfoWidget.AssignedByField[model.FieldDepartment].Entry.Text,
+		Title:        contactInfoWidget.AssignedByField[model.FieldTitle].Entry.Text,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrValidation) {
+			errorWidget.Show(fieldMsgs, b.localizer, contactInfoWidget, errorLabel)
+			return
+		}
+
+		// Контакт сохранен, но телефон или email уже есть у другого контакта
+		if errors.Is(err, model.ErrUniqueWarning) {
+			if window := parent.Window(saveButton); window != nil {
+				dialog.ShowInformation(b.localizer.T("contact.saved.title"), errorWidget.Join(fieldMsgs, b.localizer), window)
+			}
+		} else {
+			b.reporter.Error(parent.Window(saveButton), "contact.update", err, saveButton.OnTapped, "uuid", contact.UUID)
+			return
+		}
+	}
+
+	// Могли измениться дата рождения или организация
+	b.reload()
+	b.selectByUUID(contact.UUID)
+}
+
+// selectByUUID – выбрать контакт в списке, если он есть среди найденных
+func (b *Builder) selectByUUID(uuid string) {
+	if id := b.itemOf(uuid); id >= 0 {
+		b.contactsList.Select(id)
+		return
+	}
+
+	b.contactInfoBox.Objects = nil
+	b.contactInfoBox.Refresh()
+}
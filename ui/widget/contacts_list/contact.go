@@ -2,18 +2,13 @@ package contacts_list
 
 import (
 	"context"
+	"time"
 
 	"fyne.io/fyne/v2"
 
 	"contacts/internal/model"
 )
 
-type appBox interface {
-	Remove(rem fyne.CanvasObject)
-	Add(add fyne.CanvasObject)
-	Refresh()
-}
-
 type fetchHandler interface {
 	Fetch(ctx context.Context) ([]model.Contact, error)
 }
@@ -21,3 +16,70 @@ type fetchHandler interface {
 type searchHandler interface {
 	Search(ctx context.Context, request model.SearchRequest) ([]model.Contact, error)
 }
+
+type updateHandler interface {
+	Update(ctx context.Context, contactForCreate model.ContactForCreate) (map[model.Field]model.Message, error)
+}
+
+type favoriteHandler interface {
+	SetFavorite(ctx context.Context, uuid string, favorite bool) error
+}
+
+type birthdaysHandler interface {
+	Upcoming(ctx context.Context, days int) ([]model.UpcomingBirthday, error)
+}
+
+type relationsHandler interface {
+	Graph(ctx context.Context, uuid string) ([]model.RelatedContact, error)
+	Link(ctx context.Context, uuid, relatedUUID string, relationType model.RelationType) error
+	Unlink(ctx context.Context, uuid string, related model.RelatedContact) error
+}
+
+type organizationHandler interface {
+	List(ctx context.Context) ([]model.Organization, error)
+}
+
+type interactionHandler interface {
+	Log(ctx context.Context, uuid string, interactionForCreate model.InteractionForCreate) (model.Interaction, error)
+	Remove(ctx context.Context, uuid, interactionUUID string) error
+	SetFrequency(ctx context.Context, uuid string, days int) error
+}
+
+type recentStore interface {
+	List() []string
+	Add(uuid string)
+}
+
+type sorter interface {
+	Sort(contacts []model.Contact, key model.SortKey)
+	Group(contact model.Contact, key model.SortKey) string
+	Alphabet() []string
+}
+
+type validator interface {
+	ValidateField(field model.Field, value string) (model.Message, bool)
+}
+
+type avatarBuilder interface {
+	Resource(hash string) fyne.Resource
+	Pick(window fyne.Window, onPicked func(hash string))
+}
+
+type reporter interface {
+	Log(operation string, err error, attrs ...any)
+	Error(window fyne.Window, operation string, err error, retry func(), attrs ...any)
+	Message(operation string) string
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+	Plural(id string, count int, params map[string]any) string
+	Message(message model.Message) string
+}
+
+type dates interface {
+	Parse(value string) (time.Time, error)
+	Format(t time.Time) string
+	FormatDateTime(t time.Time) string
+}
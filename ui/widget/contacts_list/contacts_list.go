@@ -2,191 +2,320 @@ package contacts_list
 
 import (
 	"context"
-	"sort"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
 
 	"contacts/internal/model"
-	"contacts/ui/dto"
-	"contacts/ui/presenter/phone"
-	widgetContactInfo "contacts/ui/widget/contact_info"
-	"contacts/util/pointer"
 )
 
 var (
-	contactListSize = fyne.NewSize(350, 500)
-	contactListPos  = fyne.NewPos(50, 100)
+	rowAvatarSize       = fyne.NewSize(36, 36)
+	rowOrganizationSize = fyne.NewSize(110, 36)
 )
 
+// За сколько дней до дня рождения в строке появляется значок
+const birthdayBadgeDays = 7
+
 type Builder struct {
-	fetchHandler  fetchHandler
-	searchHandler searchHandler
-	appBox        appBox
+	fetchHandler        fetchHandler
+	searchHandler       searchHandler
+	updateHandler       updateHandler
+	favoriteHandler     favoriteHandler
+	birthdaysHandler    birthdaysHandler
+	relationsHandler    relationsHandler
+	organizationHandler organizationHandler
+	interactionHandler  interactionHandler
+	validator           validator
+	avatarBuilder       avatarBuilder
+	recent              recentStore
+	sorter              sorter
+	reporter            reporter
+	localizer           localizer
+	dates               dates
 
 	// Для хранения стейта
+	filtered        []model.Contact
+	items           []listItem                   // Строки списка: заголовки групп и контакты
+	groupIDs        map[string]widget.ListItemID // Строка заголовка для каждой группы
+	groupOrder      []string
+	daysToBirthday  map[string]int // Сколько дней до дня рождения, только для ближайших
+	rail            *fyne.Container
 	contactInfoBox  *fyne.Container
-	contactsListBox *container.Scroll
-	searchInputBox  *fyne.Container
-	searchLabelBox  *fyne.Container
+	errorBanner     *fyne.Container
+	errorLabel      *widget.Label
+	contactsList    *keyList
+	searchInput     *searchEntry
 	selectedContact *model.Contact
+	selectedID      widget.ListItemID   // Текущий контакт, от него считается выбор диапазона
+	selected        map[string]struct{} // UUID выбранных контактов
+	selectAll       *widget.Check
+	filter          listFilter
+	sortKey         model.SortKey
+	source          model.Source // Пустой – контакты из всех источников
+	sourceSelect    *widget.Select
+	refreshChips    func() // Перерисовать переключатели фильтра после смены b.filter
+
+	organizations      []model.Organization // Все организации в порядке названия
+	organizationNames  map[string]string    // Название организации по UUID для строк списка
+	organization       string               // UUID организации для отбора, пустой – все контакты
+	organizationSelect *widget.Select
+
+	onInteractionsChanged func() // Вызывается после изменения журнала или частоты общения
 }
 
-func NewBuilder(fetchHandler fetchHandler, searchHandler searchHandler, appBox appBox) *Builder {
+func NewBuilder(
+	fetchHandler fetchHandler,
+	searchHandler searchHandler,
+	updateHandler updateHandler,
+	favoriteHandler favoriteHandler,
+	birthdaysHandler birthdaysHandler,
+	relationsHandler relationsHandler,
+	organizationHandler organizationHandler,
+	interactionHandler interactionHandler,
+	validator validator,
+	avatarBuilder avatarBuilder,
+	recent recentStore,
+	sorter sorter,
+	reporter reporter,
+	localizer localizer,
+	dates dates,
+) *Builder {
 	return &Builder{
-		appBox:        appBox,
-		fetchHandler:  fetchHandler,
-		searchHandler: searchHandler,
+		fetchHandler:        fetchHandler,
+		searchHandler:       searchHandler,
+		updateHandler:       updateHandler,
+		favoriteHandler:     favoriteHandler,
+		birthdaysHandler:    birthdaysHandler,
+		relationsHandler:    relationsHandler,
+		organizationHandler: organizationHandler,
+		interactionHandler:  interactionHandler,
+		validator:           validator,
+		avatarBuilder:       avatarBuilder,
+		recent:              recent,
+		sorter:              sorter,
+		reporter:            reporter,
+		localizer:           localizer,
+		dates:               dates,
+		contactInfoBox:      container.NewStack(),
+		selectedID:          -1,
+		selected:            make(map[string]struct{}),
+		rail:                container.NewVBox(),
+		sortKey:             model.SortKeySurname,
 	}
 }
 
-func (b *Builder) ContactListBoxSize() fyne.Size {
-	return contactListSize
-}
-
-func (b *Builder) ContactListBoxPos() fyne.Position {
-	return contactListPos
-}
-
-func (b *Builder) Build() {
-	filtered, err := b.fetchHandler.Fetch(context.Background())
-	if err != nil {
-		panic(err)
-	}
-
-	sort.Sort(BySurname(filtered))
-
+// Build – поисковая строка и список контактов.
+//
+// Карточка выбранного контакта строится отдельно, см. InfoPanel.
+func (b *Builder) Build() fyne.CanvasObject {
 	// Список контактов
-	contactsList := widget.NewList(
+	b.contactsList = newKeyList(
 		func() int {
-			return len(filtered) // Количество строк в списке
+			return len(b.items) // Количество строк в списке
 		},
 		func() fyne.CanvasObject {
-			return widget.NewLabel("") // Создание элемента списка
+			// Создание элемента списка: заголовок группы или флажок, аватар и фамилия
+			return container.NewStack(newGroupHeader(), newContactRow(b.selectTapped, b.checkRow))
 		},
 		func(id int, obj fyne.CanvasObject) {
-			obj.(*widget.Label).SetText(filtered[id].Surname) // Установка текста для элемента
+			cell := obj.(*fyne.Container)
+			header := cell.Objects[0].(*groupHeader)
+			row := cell.Objects[1].(*contactRow)
+
+			contact, ok := b.contactAt(id)
+			if !ok {
+				header.label.SetText(b.items[id].header)
+				header.Show()
+				row.Hide()
+				return
+			}
+			header.Hide()
+			row.Show()
+
+			// Установка флажка, аватара, текста и значков для элемента
+			row.id = id
+
+			_, selected := b.selected[contact.UUID]
+			row.check.SetChecked(selected)
+
+			row.setAvatar(b.avatarBuilder.Resource(contact.Avatar), contact.Avatar != "", contactInitials(contact))
+			row.setTitle(b.rowTitle(contact), b.query())
+			row.setPhone(presentPhone(contact))
+			row.setOrganization(b.organizationNames[contact.OrganizationUUID])
+			row.birthday.SetText(b.birthdayBadge(contact))
+
+			if contact.Favorite {
+				row.favorite.Show()
+			} else {
+				row.favorite.Hide()
+			}
 		},
 	)
+	b.contactsList.onUp = b.SelectPrevious
+	b.contactsList.onDown = b.SelectNext
+	b.contactsList.OnSelected = b.showContact
 
-	contactsList.OnSelected = func(id int) {
-		contact := filtered[id]
-
-		b.selectedContact = &contact
-
-		contactsWidgetRowsData := []dto.ContactInfoWidgetRowData{
-			{
-				Label: "Surname",
-				Entry: dto.ContactInfoWidgetRowEntry{
-					Value:       &contact.Surname,
-					Type:        dto.ContactWidgetRowTypeText,
-					DisableEdit: true,
-				},
-			},
-			{
-				Label: "Name",
-				Entry: dto.ContactInfoWidgetRowEntry{
-					Value:       &contact.Name,
-					Type:        dto.ContactWidgetRowTypeText,
-					DisableEdit: true,
-				},
-			},
-			{
-				Label: "Birthday",
-				Entry: dto.ContactInfoWidgetRowEntry{
-					Value:       pointer.To(contact.Birthday.Format("02.01.2006")),
-					Type:        dto.ContactWidgetRowTypeDatePicker,
-					DisableEdit: true,
-				},
-			},
-			{
-				Label: "Phone",
-				Entry: dto.ContactInfoWidgetRowEntry{
-					Value:       pointer.To(phone.Present(contact.Phone.Number())),
-					Type:        dto.ContactWidgetRowTypeText,
-					DisableEdit: true,
-				},
-			},
-			{
-				Label: "Email",
-				Entry: dto.ContactInfoWidgetRowEntry{
-					Value:       &contact.Email,
-					Type:        dto.ContactWidgetRowTypeText,
-					DisableEdit: true,
-				},
-			},
-		}
-		for link, value := range contact.Links {
-			contactsWidgetRowsData = append(contactsWidgetRowsData, dto.ContactInfoWidgetRowData{
-				Label: string(link),
-				Entry: dto.ContactInfoWidgetRowEntry{
-					Value:       &value,
-					Type:        dto.ContactWidgetRowTypeText,
-					DisableEdit: true,
-				},
-			})
-		}
+	// Поисковая строка
+	b.searchInput = newSearchEntry()
 
-		contactInfoWidgetBuilder := widgetContactInfo.NewBuilder(
-			dto.Position{
-				X: 300,
-				Y: 50,
-			},
-			50,
-		)
+	// Стрелка вниз из поиска переходит к найденным контактам
+	b.searchInput.onDown = func() {
+		b.focus(b.contactsList)
+		b.SelectNext()
+	}
+
+	// Обработка ввода в поисковой строке
+	b.searchInput.OnChanged = func(_ string) {
+		b.load()
+	}
 
-		contactInfoWidget := contactInfoWidgetBuilder.Build(contactsWidgetRowsData)
+	// Текст для поисковой строки
+	searchLabel := widget.NewLabel(b.localizer.T("search.label"))
+
+	// Выбор всех найденных контактов для массовых действий
+	b.selectAll = widget.NewCheck(b.localizer.T("list.select_all"), b.checkAll)
+
+	// Баннер вместо списка, если контакты не удалось загрузить
+	b.errorLabel = widget.NewLabel("")
+	b.errorLabel.Wrapping = fyne.TextWrapWord
+	b.errorLabel.Importance = widget.DangerImportance
+	retryButton := widget.NewButton(b.localizer.T("error.retry"), b.reload)
+	b.errorBanner = container.NewBorder(nil, nil, nil, retryButton, b.errorLabel)
+	b.errorBanner.Hide()
+
+	b.reload()
+
+	return container.NewBorder(
+		container.NewVBox(
+			container.NewBorder(nil, nil, searchLabel, nil, b.searchInput),
+			b.errorBanner,
+			b.buildFilterChips(),
+			container.NewBorder(nil, nil, b.selectAll, container.NewHBox(b.buildOrganizationSelect(), b.buildSourceSelect(), b.buildSortSelect())),
+		),
+		nil,
+		nil,
+		container.NewVScroll(b.rail),
+		b.contactsList,
+	)
+}
 
-		b.appBox.Remove(b.contactInfoBox)
-		b.contactInfoBox = contactInfoWidget.Box
-		b.appBox.Add(b.contactInfoBox)
+// InfoPanel – карточка выбранного контакта, содержимое меняется при выборе
+func (b *Builder) InfoPanel() fyne.CanvasObject {
+	return container.NewVScroll(b.contactInfoBox)
+}
+
+// load – перечитывает контакты с учетом поискового запроса
+func (b *Builder) load() {
+	var (
+		filtered []model.Contact
+		err      error
+	)
+
+	query := b.query()
+
+	if query == "" {
+		filtered, err = b.fetchHandler.Fetch(context.Background())
+	} else {
+		filtered, err = b.searchHandler.Search(context.Background(), model.SearchRequest{
+			Query: query,
+		})
 	}
-	b.contactsListBox = container.NewVScroll(contactsList)
-	b.contactsListBox.Resize(contactListSize)
-	b.contactsListBox.Move(contactListPos)
+	// Ошибка чтения не роняет приложение: показываем пустой список и баннер с повтором
+	if err != nil {
+		b.reporter.Log("contacts.load", err, "query", query)
+
+		filtered = nil
+		b.errorLabel.SetText(b.reporter.Message("contacts.load") + ": " + err.Error())
+		b.errorBanner.Show()
+	} else {
+		b.errorBanner.Hide()
+	}
+
+	b.sorter.Sort(filtered, b.sortKey)
 
-	b.appBox.Add(b.contactsListBox)
+	b.group(b.arrange(filtered))
+	b.refreshRail()
 
-	if b.searchInputBox != nil {
+	// После перезагрузки списка выбранного контакта нет
+	b.contactsList.UnselectAll()
+	b.selectedID = -1
+	b.selected = make(map[string]struct{})
+	b.selectAll.SetChecked(false)
+	b.contactsList.Refresh()
+}
+
+// reload – перечитывает вместе со списком дни рождения и организации.
+//
+// Нужен, когда контакты или организации изменились. Поиск, фильтры и сортировка
+// меняют только отбор, им хватает load.
+func (b *Builder) reload() {
+	b.loadBirthdays()
+	b.loadOrganizations()
+	b.load()
+}
+
+// loadBirthdays – ближайшие дни рождения для значков в строках.
+//
+// Ошибка не мешает показать список, значки просто не появятся.
+func (b *Builder) loadBirthdays() {
+	b.daysToBirthday = make(map[string]int)
+
+	upcoming, err := b.birthdaysHandler.Upcoming(context.Background(), birthdayBadgeDays)
+	if err != nil {
+		b.reporter.Log("birthdays.load", err, "days", birthdayBadgeDays)
 		return
 	}
 
-	// Поисковая строка
-	searchInput := widget.NewEntry()
+	for _, birthday := range upcoming {
+		b.daysToBirthday[birthday.Contact.UUID] = birthday.DaysLeft
+	}
+}
 
-	// Обработка ввода в поисковой строке
-	searchInput.OnChanged = func(text string) {
-		filtered, err = b.searchHandler.Search(context.Background(), model.SearchRequest{
-			Query: text,
-		})
-		if err != nil {
-			panic(err)
-		}
+// loadOrganizations – организации для колонки в строках и отбора над списком.
+//
+// Ошибка не мешает показать список, колонка останется пустой.
+func (b *Builder) loadOrganizations() {
+	organizations, err := b.organizationHandler.List(context.Background())
+	if err != nil {
+		b.reporter.Log("organizations.load", err)
+		organizations = nil
+	}
 
-		sort.Sort(BySurname(filtered))
+	b.organizations = organizations
+	b.organizationNames = make(map[string]string, len(organizations))
+	for _, organization := range organizations {
+		b.organizationNames[organization.UUID] = organization.Name
+	}
 
-		contactsList.Refresh()
+	b.refreshOrganizationSelect()
+}
+
+// birthdayBadge – надпись значка о дне рождения, пустая – день рождения не скоро
+func (b *Builder) birthdayBadge(contact model.Contact) string {
+	days, ok := b.daysToBirthday[contact.UUID]
+	if !ok {
+		return ""
 	}
 
-	searchInputBox := container.NewVBox(searchInput)
-	searchInputBox.Resize(fyne.NewSize(300, 40))
-	searchInputBox.Move(fyne.NewPos(100, 50))
+	if days == 0 {
+		return b.localizer.T("list.badge.birthday.today")
+	}
 
-	// Текст для поисковой строки
-	searchLabel := widget.NewLabel("Find:")
-	searchLabel.Alignment = fyne.TextAlignLeading
-	searchLabelBox := container.NewVBox(searchLabel)
-	searchLabelBox.Resize(fyne.NewSize(50, 40))
-	searchLabelBox.Move(fyne.NewPos(50, 50))
+	return b.localizer.Plural("list.badge.birthday.in", days, nil)
+}
 
-	// Добавляем компоненты в приложение
-	b.appBox.Add(searchInputBox)
-	b.appBox.Add(searchLabelBox)
+// query – текущий поисковый запрос
+func (b *Builder) query() string {
+	if b.searchInput == nil {
+		return ""
+	}
 
-	return
+	return b.searchInput.Text
 }
 
+// SelectedContactUUID – контакт, показанный в карточке, nil – если выбрано несколько
 func (b *Builder) SelectedContactUUID() *string {
 	if b.selectedContact == nil {
 		return nil
@@ -195,12 +324,65 @@ func (b *Builder) SelectedContactUUID() *string {
 	return &b.selectedContact.UUID
 }
 
-func (b *Builder) Refresh() {
-	defer b.appBox.Refresh()
+// FocusSearch – перевести фокус в поисковую строку
+func (b *Builder) FocusSearch() {
+	b.focus(b.searchInput)
+}
 
-	// Удаляем предыдущее наполнение
-	b.appBox.Remove(b.contactsListBox)
-	b.appBox.Remove(b.contactInfoBox)
+// SelectNext – выбрать следующий контакт в списке, заголовки групп пропускаются
+func (b *Builder) SelectNext() {
+	if b.contactsList == nil {
+		return
+	}
+
+	for id := b.selectedID + 1; id < len(b.items); id++ {
+		if _, ok := b.contactAt(id); ok {
+			b.contactsList.Select(id)
+			return
+		}
+	}
+}
+
+// SelectPrevious – выбрать предыдущий контакт в списке, заголовки групп пропускаются
+func (b *Builder) SelectPrevious() {
+	if b.contactsList == nil {
+		return
+	}
+
+	for id := b.selectedID - 1; id >= 0; id-- {
+		if _, ok := b.contactAt(id); ok {
+			b.contactsList.Select(id)
+			return
+		}
+	}
+}
+
+func (b *Builder) focus(object focusable) {
+	canvas := fyne.CurrentApp().Driver().CanvasForObject(object)
+	if canvas == nil {
+		return
+	}
+
+	canvas.Focus(object)
+}
+
+// Open – выбрать контакт и показать его карточку.
+//
+// Если контакт скрыт поиском или фильтром, список сначала показывает все контакты.
+func (b *Builder) Open(uuid string) {
+	b.openRelated(uuid)
+}
+
+// OnInteractionsChanged – подписка на изменение журнала или частоты общения, например для панели "Пора связаться"
+func (b *Builder) OnInteractionsChanged(onChanged func()) {
+	b.onInteractionsChanged = onChanged
+}
+
+func (b *Builder) Refresh() {
+	// Карточка удаленного или измененного контакта больше не актуальна
+	b.selectedContact = nil
+	b.contactInfoBox.Objects = nil
+	b.contactInfoBox.Refresh()
 
-	b.Build()
+	b.reload()
 }
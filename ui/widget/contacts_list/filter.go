@@ -0,0 +1,191 @@
+package contacts_list
+
+import (
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+)
+
+// listFilter – какие контакты показывать в списке
+type listFilter int
+
+const (
+	filterAll       listFilter = iota // Все, избранные закреплены сверху
+	filterFavorites                   // Только избранные
+	filterRecent                      // Недавно открытые, последний – первый
+)
+
+// buildFilterChips – переключатели фильтра над списком, активный выделен цветом
+func (b *Builder) buildFilterChips() fyne.CanvasObject {
+	chips := map[listFilter]*widget.Button{}
+
+	update := func() {
+		for filter, chip := range chips {
+			if filter == b.filter {
+				chip.Importance = widget.HighImportance
+			} else {
+				chip.Importance = widget.MediumImportance
+			}
+			chip.Refresh()
+		}
+	}
+
+	chip := func(filter listFilter, title string) *widget.Button {
+		chips[filter] = widget.NewButton(title, func() {
+			b.filter = filter
+			update()
+			b.load()
+		})
+
+		return chips[filter]
+	}
+
+	box := container.NewHBox(
+		chip(filterAll, b.localizer.T("list.filter.all")),
+		chip(filterFavorites, b.localizer.T("list.filter.favorites")),
+		chip(filterRecent, b.localizer.T("list.filter.recent")),
+	)
+	update()
+	b.refreshChips = update
+
+	return box
+}
+
+// buildSourceSelect – отбор контактов по источнику, первый вариант – все источники
+func (b *Builder) buildSourceSelect() *widget.Select {
+	titles := []string{b.localizer.T("list.source.all")}
+	for _, source := range model.Sources {
+		titles = append(titles, b.localizer.T("source."+string(source)))
+	}
+
+	sourceSelect := widget.NewSelect(titles, func(title string) {
+		index := slices.Index(titles, title)
+		if index <= 0 {
+			b.source = ""
+		} else {
+			b.source = model.Sources[index-1]
+		}
+		b.load()
+	})
+	sourceSelect.SetSelectedIndex(slices.Index(model.Sources, b.source) + 1)
+	b.sourceSelect = sourceSelect
+
+	return sourceSelect
+}
+
+// buildOrganizationSelect – отбор сотрудников одной организации, первый вариант – все контакты.
+//
+// Варианты обновляются, когда организации перечитываются, см. reload.
+func (b *Builder) buildOrganizationSelect() *widget.Select {
+	b.organizationSelect = widget.NewSelect(nil, func(string) {
+		organization := ""
+		if index := b.organizationSelect.SelectedIndex(); index > 0 && index <= len(b.organizations) {
+			organization = b.organizations[index-1].UUID
+		}
+
+		// Выбор меняется и при обновлении вариантов, список тогда перечитывается следом
+		if organization == b.organization {
+			return
+		}
+
+		b.organization = organization
+		b.load()
+	})
+	b.refreshOrganizationSelect()
+
+	return b.organizationSelect
+}
+
+// refreshOrganizationSelect – варианты отбора по организации из b.organizations.
+//
+// Если выбранной организации больше нет, например после объединения, показываются все контакты.
+func (b *Builder) refreshOrganizationSelect() {
+	if b.organizationSelect == nil {
+		return
+	}
+
+	titles := []string{b.localizer.T("list.organization.all")}
+	for _, organization := range b.organizations {
+		titles = append(titles, organization.Name)
+	}
+
+	index := slices.IndexFunc(b.organizations, func(organization model.Organization) bool {
+		return organization.UUID == b.organization
+	})
+	if index < 0 {
+		b.organization = ""
+	}
+
+	b.organizationSelect.Options = titles
+	b.organizationSelect.SetSelectedIndex(index + 1)
+}
+
+// arrange – состав и порядок списка для выбранного фильтра.
+//
+// contacts уже отсортированы по выбранному полю.
+func (b *Builder) arrange(contacts []model.Contact) []model.Contact {
+	// Источник и организация отбираются до фильтра, чтобы работать вместе с избранными и недавними
+	if b.source != "" {
+		contacts = slices.DeleteFunc(slices.Clone(contacts), func(contact model.Contact) bool {
+			return contact.Source != b.source
+		})
+	}
+	if b.organization != "" {
+		contacts = slices.DeleteFunc(slices.Clone(contacts), func(contact model.Contact) bool {
+			return contact.OrganizationUUID != b.organization
+		})
+	}
+
+	arranged := make([]model.Contact, 0, len(contacts))
+
+	switch b.filter {
+	case filterFavorites:
+		for _, contact := range contacts {
+			if contact.Favorite {
+				arranged = append(arranged, contact)
+			}
+		}
+	case filterRecent:
+		byUUID := make(map[string]model.Contact, len(contacts))
+		for _, contact := range contacts {
+			byUUID[contact.UUID] = contact
+		}
+
+		// Удаленные контакты и не прошедшие поиск пропускаем
+		for _, uuid := range b.recent.List() {
+			if contact, ok := byUUID[uuid]; ok {
+				arranged = append(arranged, contact)
+			}
+		}
+	default:
+		for _, contact := range contacts {
+			if contact.Favorite {
+				arranged = append(arranged, contact)
+			}
+		}
+		for _, contact := range contacts {
+			if !contact.Favorite {
+				arranged = append(arranged, contact)
+			}
+		}
+	}
+
+	return arranged
+}
+
+// resetFilters – показать все контакты: без поиска, фильтра и отбора по источнику и организации
+func (b *Builder) resetFilters() {
+	b.filter = filterAll
+	b.refreshChips()
+
+	// Смена значений сама перечитывает список, если они изменились
+	b.sourceSelect.SetSelectedIndex(0)
+	b.organizationSelect.SetSelectedIndex(0)
+	b.searchInput.SetText("")
+
+	b.load()
+}
@@ -0,0 +1,238 @@
+package contacts_list
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/domain/order"
+	"contacts/internal/model"
+)
+
+// Сколько первых букв названия месяца показывать в указателе
+const railMonthLength = 3
+
+// listItem – строка списка: заголовок группы или контакт
+type listItem struct {
+	header  string // Текст заголовка группы
+	contact int    // Индекс контакта в filtered, -1 – строка является заголовком
+}
+
+// groupHeader – заголовок группы в списке, щелчок по нему ничего не выбирает
+type groupHeader struct {
+	widget.BaseWidget
+
+	label *widget.Label
+}
+
+func newGroupHeader() *groupHeader {
+	header := &groupHeader{
+		label: widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	}
+	header.ExtendBaseWidget(header)
+
+	return header
+}
+
+func (h *groupHeader) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(h.label)
+}
+
+func (h *groupHeader) Tapped(*fyne.PointEvent) {}
+
+// sortOptions – варианты сортировки в порядке показа в выпадающем списке
+var sortOptions = []model.SortKey{
+	model.SortKeySurname,
+	model.SortKeyName,
+	model.SortKeyBirthday,
+	model.SortKeyCreated,
+	model.SortKeyUpdated,
+}
+
+// buildSortSelect – выбор поля, по которому упорядочен и сгруппирован список
+func (b *Builder) buildSortSelect() *widget.Select {
+	titles := make([]string, 0, len(sortOptions))
+	for _, key := range sortOptions {
+		titles = append(titles, b.localizer.T("list.sort."+string(key)))
+	}
+
+	sortSelect := widget.NewSelect(titles, func(title string) {
+		b.sortKey = sortOptions[slices.Index(titles, title)]
+		b.load()
+	})
+	sortSelect.SetSelectedIndex(slices.Index(sortOptions, b.sortKey))
+
+	return sortSelect
+}
+
+// group – строки списка с заголовками групп.
+//
+// В "Все" избранные идут отдельной секцией сверху, недавние показываются без групп в порядке открытия.
+func (b *Builder) group(contacts []model.Contact) {
+	b.filtered = contacts
+	b.items = make([]listItem, 0, len(contacts))
+	b.groupIDs = make(map[string]widget.ListItemID)
+	b.groupOrder = nil
+
+	if b.filter == filterRecent {
+		for i := range contacts {
+			b.items = append(b.items, listItem{contact: i})
+		}
+		return
+	}
+
+	start := 0
+	if b.filter == filterAll {
+		for start < len(contacts) && contacts[start].Favorite {
+			start++
+		}
+
+		if start > 0 {
+			b.items = append(b.items, listItem{header: b.localizer.T("list.group.favorites"), contact: -1})
+			for i := 0; i < start; i++ {
+				b.items = append(b.items, listItem{contact: i})
+			}
+		}
+	}
+
+	current := ""
+	for i := start; i < len(contacts); i++ {
+		group := b.sorter.Group(contacts[i], b.sortKey)
+
+		if i == start || group != current {
+			if _, ok := b.groupIDs[group]; !ok {
+				b.groupIDs[group] = len(b.items)
+				b.groupOrder = append(b.groupOrder, group)
+			}
+
+			b.items = append(b.items, listItem{header: b.groupTitle(group), contact: -1})
+			current = group
+		}
+
+		b.items = append(b.items, listItem{contact: i})
+	}
+}
+
+// groupTitle – заголовок группы: буква или название месяца
+func (b *Builder) groupTitle(group string) string {
+	switch b.sortKey {
+	case model.SortKeyBirthday:
+		if group == order.NoGroup {
+			return b.localizer.T("list.group.no_birthday")
+		}
+
+		return b.localizer.T("month." + group)
+	case model.SortKeyCreated, model.SortKeyUpdated:
+		if group == order.NoGroup {
+			return b.localizer.T("list.group.no_date")
+		}
+
+		month, err := time.Parse(order.MonthLayout, group)
+		if err != nil {
+			return group
+		}
+
+		return b.localizer.T("month."+strconv.Itoa(int(month.Month()))) + " " + strconv.Itoa(month.Year())
+	}
+
+	return group
+}
+
+// alphabetical – группы списка являются буквами алфавита
+func (b *Builder) alphabetical() bool {
+	return b.sortKey == model.SortKeySurname || b.sortKey == model.SortKeyName
+}
+
+// refreshRail – алфавитный указатель справа от списка.
+//
+// Для фамилии и имени показывается весь алфавит, буквы без контактов неактивны.
+// Для дат – месяцы, в которых есть контакты.
+func (b *Builder) refreshRail() {
+	var groups []string
+	if b.filter != filterRecent && b.alphabetical() {
+		groups = slices.Clone(b.sorter.Alphabet())
+	}
+
+	// Группы вне алфавита: латиница, цифры, месяцы
+	for _, group := range b.groupOrder {
+		if !slices.Contains(groups, group) {
+			groups = append(groups, group)
+		}
+	}
+
+	objects := make([]fyne.CanvasObject, 0, len(groups))
+	for _, group := range groups {
+		id, ok := b.groupIDs[group]
+
+		button := widget.NewButton(b.railTitle(group), func() {
+			b.contactsList.ScrollTo(id)
+		})
+		button.Importance = widget.LowImportance
+		if !ok {
+			button.Disable()
+		}
+
+		objects = append(objects, button)
+	}
+
+	b.rail.Objects = objects
+	b.rail.Refresh()
+}
+
+// railTitle – подпись в указателе, название месяца сокращается, от года остаются две цифры
+func (b *Builder) railTitle(group string) string {
+	if b.alphabetical() {
+		return b.groupTitle(group)
+	}
+
+	title := b.groupTitle(group)
+
+	var year string
+	if b.sortKey != model.SortKeyBirthday && group != order.NoGroup {
+		title, year, _ = strings.Cut(title, " ")
+	}
+
+	short := []rune(title)
+	if len(short) > railMonthLength {
+		short = short[:railMonthLength]
+	}
+
+	if len(year) > 2 {
+		return string(short) + " " + year[len(year)-2:]
+	}
+
+	return string(short)
+}
+
+// contactAt – контакт в строке списка, false – строка является заголовком группы
+func (b *Builder) contactAt(id widget.ListItemID) (model.Contact, bool) {
+	if id < 0 || id >= len(b.items) || b.items[id].contact < 0 {
+		return model.Contact{}, false
+	}
+
+	return b.filtered[b.items[id].contact], true
+}
+
+// itemOf – строка списка с контактом, -1 – контакта нет среди найденных
+func (b *Builder) itemOf(uuid string) widget.ListItemID {
+	for id := range b.items {
+		if contact, ok := b.contactAt(id); ok && contact.UUID == uuid {
+			return id
+		}
+	}
+
+	return -1
+}
+
+// rowTitle – подпись контакта в списке, первым идет поле сортировки
+func (b *Builder) rowTitle(contact model.Contact) string {
+	if b.sortKey == model.SortKeyName {
+		return strings.TrimSpace(contact.Name + " " + contact.Surname)
+	}
+
+	return strings.TrimSpace(contact.Surname + " " + contact.Name)
+}
@@ -0,0 +1,204 @@
+package contacts_list
+
+import (
+	"context"
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+	"contacts/ui/widget/parent"
+)
+
+// Сколько последних записей журнала показывать в карточке
+const interactionsShown = 5
+
+// Частоты общения на выбор, в днях, 0 – не напоминать
+var keepInTouchOptions = []int{0, 7, 14, 30, 60, 90}
+
+// buildInteractions – журнал общения в карточке: когда общались последний раз,
+// как часто напоминать связаться, последние записи и кнопка новой записи.
+func (b *Builder) buildInteractions(contact model.Contact) fyne.CanvasObject {
+	title := widget.NewLabelWithStyle(b.localizer.T("contact.interactions"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+
+	var logButton *widget.Button
+	logButton = widget.NewButtonWithIcon(b.localizer.T("contact.interaction.add"), theme.ContentAddIcon(), func() {
+		b.showLogDialog(contact, parent.Window(logButton))
+	})
+	logButton.Importance = widget.LowImportance
+
+	last := b.localizer.T("contact.interaction.never")
+	if lastContacted := contact.LastContacted(); !lastContacted.IsZero() {
+		last = b.localizer.Format("contact.interaction.last", map[string]any{"Date": b.dates.Format(lastContacted)})
+	}
+
+	box := container.NewVBox(
+		container.NewBorder(nil, nil, nil, logButton, title),
+		widget.NewLabel(last),
+		container.NewBorder(nil, nil, widget.NewLabel(b.localizer.T("contact.keep_in_touch")), nil, b.buildFrequencySelect(contact)),
+	)
+
+	for _, interaction := range contact.Interactions[:min(len(contact.Interactions), interactionsShown)] {
+		interaction := interaction
+
+		text := widget.NewLabel(b.presentInteraction(interaction))
+		text.Wrapping = fyne.TextWrapWord
+
+		var removeButton *widget.Button
+		removeButton = widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+			b.removeInteraction(contact, interaction, parent.Window(removeButton))
+		})
+		removeButton.Importance = widget.LowImportance
+
+		box.Add(container.NewBorder(nil, nil, nil, removeButton, text))
+	}
+
+	if hidden := len(contact.Interactions) - interactionsShown; hidden > 0 {
+		box.Add(widget.NewLabel(b.localizer.Plural("contact.interaction.more", hidden, nil)))
+	}
+
+	return box
+}
+
+// buildFrequencySelect – как часто напоминать связаться с контактом
+func (b *Builder) buildFrequencySelect(contact model.Contact) *widget.Select {
+	// Частота, заданная не из списка, тоже должна быть видна
+	options := slices.Clone(keepInTouchOptions)
+	if !slices.Contains(options, contact.KeepInTouch) {
+		options = append(options, contact.KeepInTouch)
+		slices.Sort(options)
+	}
+
+	titles := make([]string, 0, len(options))
+	for _, days := range options {
+		titles = append(titles, b.frequencyTitle(days))
+	}
+
+	frequencySelect := widget.NewSelect(titles, nil)
+	frequencySelect.SetSelectedIndex(slices.Index(options, contact.KeepInTouch))
+
+	// Обработчик назначается после начального выбора, SetSelectedIndex вызывает OnChanged
+	var setFrequency func(string)
+	setFrequency = func(string) {
+		days := options[frequencySelect.SelectedIndex()]
+		if days == contact.KeepInTouch {
+			return
+		}
+
+		err := b.interactionHandler.SetFrequency(context.Background(), contact.UUID, days)
+		if err != nil {
+			b.reporter.Error(parent.Window(frequencySelect), "contact.keep_in_touch", err, func() { setFrequency("") }, "uuid", contact.UUID, "days", days)
+			return
+		}
+
+		b.interactionsChanged(contact.UUID)
+	}
+	frequencySelect.OnChanged = setFrequency
+
+	return frequencySelect
+}
+
+// frequencyTitle – подпись частоты общения, например "Раз в 30 дней"
+func (b *Builder) frequencyTitle(days int) string {
+	if days == 0 {
+		return b.localizer.T("contact.keep_in_touch.off")
+	}
+
+	return b.localizer.Plural("contact.keep_in_touch.every", days, nil)
+}
+
+// presentInteraction – строка вида "02.10.2026, звонок – обсудили отпуск"
+func (b *Builder) presentInteraction(interaction model.Interaction) string {
+	params := map[string]any{
+		"Date": b.dates.Format(interaction.Date),
+		"Type": b.localizer.T("interaction." + string(interaction.Type)),
+		"Note": interaction.Note,
+	}
+
+	if interaction.Note == "" {
+		return b.localizer.Format("contact.interaction.row.nonote", params)
+	}
+
+	return b.localizer.Format("contact.interaction.row", params)
+}
+
+// showLogDialog – запись нового общения: как общались, когда и о чем
+func (b *Builder) showLogDialog(contact model.Contact, window fyne.Window) {
+	if window == nil {
+		return
+	}
+
+	typeTitles := make([]string, 0, len(model.InteractionTypes))
+	for _, interactionType := range model.InteractionTypes {
+		typeTitles = append(typeTitles, b.localizer.T("interaction."+string(interactionType)))
+	}
+
+	typeSelect := widget.NewSelect(typeTitles, nil)
+	typeSelect.SetSelectedIndex(0)
+
+	dateEntry := widget.NewEntry()
+	dateEntry.SetPlaceHolder(b.localizer.T("placeholder.interaction.date"))
+
+	noteEntry := widget.NewMultiLineEntry()
+	noteEntry.SetPlaceHolder(b.localizer.T("placeholder.interaction.note"))
+	noteEntry.Wrapping = fyne.TextWrapWord
+
+	var logInteraction func(ok bool)
+	logInteraction = func(ok bool) {
+		if !ok {
+			return
+		}
+
+		_, err := b.interactionHandler.Log(context.Background(), contact.UUID, model.InteractionForCreate{
+			Type: model.InteractionTypes[typeSelect.SelectedIndex()],
+			Date: dateEntry.Text,
+			Note: noteEntry.Text,
+		})
+		if err != nil {
+			b.reporter.Error(window, "contact.interaction", err, func() { logInteraction(true) }, "uuid", contact.UUID, "date", dateEntry.Text)
+			return
+		}
+
+		b.interactionsChanged(contact.UUID)
+	}
+
+	dialog.ShowForm(
+		b.localizer.T("contact.interaction.title"),
+		b.localizer.T("button.ok"),
+		b.localizer.T("button.cancel"),
+		[]*widget.FormItem{
+			widget.NewFormItem(b.localizer.T("contact.interaction.type"), typeSelect),
+			widget.NewFormItem(b.localizer.T("contact.interaction.date"), dateEntry),
+			widget.NewFormItem(b.localizer.T("contact.interaction.note"), noteEntry),
+		},
+		logInteraction,
+		window,
+	)
+}
+
+// removeInteraction – удаляет запись из журнала и перестраивает карточку
+func (b *Builder) removeInteraction(contact model.Contact, interaction model.Interaction, window fyne.Window) {
+	err := b.interactionHandler.Remove(context.Background(), contact.UUID, interaction.UUID)
+	if err != nil {
+		b.reporter.Error(window, "contact.interaction", err, func() {
+			b.removeInteraction(contact, interaction, window)
+		}, "uuid", contact.UUID, "interaction", interaction.UUID)
+		return
+	}
+
+	b.interactionsChanged(contact.UUID)
+}
+
+// interactionsChanged – карточка перестраивается вместе со списком, подписчик узнает об изменении
+func (b *Builder) interactionsChanged(uuid string) {
+	b.load()
+	b.selectByUUID(uuid)
+
+	if b.onInteractionsChanged != nil {
+		b.onInteractionsChanged()
+	}
+}
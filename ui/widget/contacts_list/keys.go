@@ -0,0 +1,98 @@
+package contacts_list
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// focusable – виджет, который может получить фокус ввода
+type focusable interface {
+	fyne.CanvasObject
+	fyne.Focusable
+}
+
+// keyList – список, в котором стрелки сразу выбирают контакт,
+// а остальные клавиши уходят в обработчик окна (редактирование, удаление)
+type keyList struct {
+	widget.List
+
+	onUp   func()
+	onDown func()
+}
+
+func newKeyList(
+	length func() int,
+	createItem func() fyne.CanvasObject,
+	updateItem func(widget.ListItemID, fyne.CanvasObject),
+) *keyList {
+	list := &keyList{}
+	list.Length = length
+	list.CreateItem = createItem
+	list.UpdateItem = updateItem
+	list.ExtendBaseWidget(list)
+
+	return list
+}
+
+func (l *keyList) TypedKey(event *fyne.KeyEvent) {
+	switch event.Name {
+	case fyne.KeyUp:
+		l.onUp()
+	case fyne.KeyDown:
+		l.onDown()
+	default:
+		forwardKey(l, event)
+	}
+}
+
+// searchEntry – поисковая строка: стрелка вниз переходит к списку,
+// шорткаты окна (Ctrl+N и т.д.) работают и при фокусе в строке
+type searchEntry struct {
+	widget.Entry
+
+	onDown func()
+}
+
+func newSearchEntry() *searchEntry {
+	entry := &searchEntry{}
+	entry.ExtendBaseWidget(entry)
+
+	return entry
+}
+
+func (e *searchEntry) TypedKey(event *fyne.KeyEvent) {
+	switch event.Name {
+	case fyne.KeyDown:
+		e.onDown()
+	case fyne.KeyEscape:
+		e.SetText("")
+	default:
+		e.Entry.TypedKey(event)
+	}
+}
+
+func (e *searchEntry) TypedShortcut(shortcut fyne.Shortcut) {
+	e.Entry.TypedShortcut(shortcut)
+
+	if _, ok := shortcut.(*desktop.CustomShortcut); !ok {
+		return
+	}
+
+	canvas := fyne.CurrentApp().Driver().CanvasForObject(e)
+	if handler, ok := canvas.(fyne.Shortcutable); ok {
+		handler.TypedShortcut(shortcut)
+	}
+}
+
+// forwardKey – передать нажатие обработчику окна, в котором находится виджет
+func forwardKey(object fyne.CanvasObject, event *fyne.KeyEvent) {
+	canvas := fyne.CurrentApp().Driver().CanvasForObject(object)
+	if canvas == nil {
+		return
+	}
+
+	if onTypedKey := canvas.OnTypedKey(); onTypedKey != nil {
+		onTypedKey(event)
+	}
+}
@@ -0,0 +1,142 @@
+package contacts_list
+
+import (
+	"context"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+	widgetContactInfo "contacts/ui/widget/contact_info"
+	"contacts/ui/widget/parent"
+)
+
+// buildRelations – связи контакта в карточке и кнопка добавления связи.
+//
+// Если связи не удалось прочитать, карточка показывается без них.
+func (b *Builder) buildRelations(contact model.Contact, contactInfoWidgetBuilder *widgetContactInfo.Builder) fyne.CanvasObject {
+	title := widget.NewLabelWithStyle(b.localizer.T("contact.relations"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+
+	var addButton *widget.Button
+	addButton = widget.NewButtonWithIcon(b.localizer.T("contact.relation.add"), theme.ContentAddIcon(), func() {
+		b.showLinkDialog(contact, parent.Window(addButton))
+	})
+	addButton.Importance = widget.LowImportance
+
+	box := container.NewVBox(container.NewBorder(nil, nil, nil, addButton, title))
+
+	related, err := b.relationsHandler.Graph(context.Background(), contact.UUID)
+	if err != nil {
+		b.reporter.Log("relations.load", err, "uuid", contact.UUID)
+		return box
+	}
+
+	if len(related) > 0 {
+		box.Add(contactInfoWidgetBuilder.BuildRelations(related, b.openRelated, func(relatedContact model.RelatedContact) {
+			b.unlink(contact, relatedContact, parent.Window(addButton))
+		}))
+	}
+
+	return box
+}
+
+// showLinkDialog – выбор типа связи и контакта, с которым связать текущий
+func (b *Builder) showLinkDialog(contact model.Contact, window fyne.Window) {
+	if window == nil {
+		return
+	}
+
+	contacts, err := b.fetchHandler.Fetch(context.Background())
+	if err != nil {
+		b.reporter.Error(window, "contacts.load", err, func() {
+			b.showLinkDialog(contact, window)
+		})
+		return
+	}
+
+	// Связать можно с любым другим контактом, список в алфавитном порядке
+	others := make([]model.Contact, 0, len(contacts))
+	for _, other := range contacts {
+		if other.UUID != contact.UUID {
+			others = append(others, other)
+		}
+	}
+	b.sorter.Sort(others, model.SortKeySurname)
+
+	names := make([]string, 0, len(others))
+	for _, other := range others {
+		names = append(names, strings.TrimSpace(other.Surname+" "+other.Name))
+	}
+
+	typeTitles := make([]string, 0, len(model.RelationTypes))
+	for _, relationType := range model.RelationTypes {
+		typeTitles = append(typeTitles, b.localizer.T("relation."+string(relationType)))
+	}
+
+	typeSelect := widget.NewSelect(typeTitles, nil)
+	typeSelect.SetSelectedIndex(0)
+
+	contactSelect := widget.NewSelect(names, nil)
+	contactSelect.PlaceHolder = b.localizer.T("contact.relation.pick")
+
+	var link func(ok bool)
+	link = func(ok bool) {
+		if !ok || contactSelect.SelectedIndex() < 0 {
+			return
+		}
+
+		related := others[contactSelect.SelectedIndex()]
+		relationType := model.RelationTypes[typeSelect.SelectedIndex()]
+
+		err := b.relationsHandler.Link(context.Background(), contact.UUID, related.UUID, relationType)
+		if err != nil {
+			b.reporter.Error(window, "contact.relation", err, func() { link(true) }, "uuid", contact.UUID, "related", related.UUID)
+			return
+		}
+
+		// Карточка перестраивается вместе со списком
+		b.load()
+		b.selectByUUID(contact.UUID)
+	}
+
+	dialog.ShowForm(
+		b.localizer.T("contact.relation.title"),
+		b.localizer.T("button.ok"),
+		b.localizer.T("button.cancel"),
+		[]*widget.FormItem{
+			widget.NewFormItem(b.localizer.T("contact.relation.type"), typeSelect),
+			widget.NewFormItem(b.localizer.T("contact.relation.contact"), contactSelect),
+		},
+		link,
+		window,
+	)
+}
+
+// unlink – удаляет связь и перестраивает карточку
+func (b *Builder) unlink(contact model.Contact, related model.RelatedContact, window fyne.Window) {
+	err := b.relationsHandler.Unlink(context.Background(), contact.UUID, related)
+	if err != nil {
+		b.reporter.Error(window, "contact.relation", err, func() {
+			b.unlink(contact, related, window)
+		}, "uuid", contact.UUID, "related", related.Contact.UUID)
+		return
+	}
+
+	b.load()
+	b.selectByUUID(contact.UUID)
+}
+
+// openRelated – переходит к связанному контакту.
+//
+// Если контакт скрыт поиском или фильтром, список сначала показывает все контакты.
+func (b *Builder) openRelated(uuid string) {
+	if b.itemOf(uuid) < 0 {
+		b.resetFilters()
+	}
+
+	b.selectByUUID(uuid)
+}
@@ -0,0 +1,232 @@
+package contacts_list
+
+import (
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/domain/match"
+	"contacts/internal/model"
+	"contacts/ui/presenter/phone"
+	"contacts/ui/resources"
+)
+
+// contactRow – строка списка: флажок выбора, фото или инициалы, полное имя, телефон,
+// колонка с организацией и значки ближайшего дня рождения и избранного.
+//
+// Щелчок по строке обрабатывается здесь, а не списком,
+// чтобы знать, были ли зажаты Shift или Ctrl.
+type contactRow struct {
+	widget.BaseWidget
+
+	check        *widget.Check
+	image        *canvas.Image
+	initials     *initials
+	title        *widget.RichText
+	phone        *widget.RichText
+	organization *widget.RichText // Колонка одной ширины, чтобы значки справа стояли ровно
+	birthday     *badge
+	favorite     *widget.Icon
+
+	id       widget.ListItemID
+	modifier fyne.KeyModifier // Модификаторы последнего нажатия мыши
+
+	onTapped  func(id widget.ListItemID, modifier fyne.KeyModifier)
+	onChecked func(id widget.ListItemID, checked bool)
+}
+
+func newContactRow(
+	onTapped func(id widget.ListItemID, modifier fyne.KeyModifier),
+	onChecked func(id widget.ListItemID, checked bool),
+) *contactRow {
+	row := &contactRow{
+		image:        canvas.NewImageFromResource(nil),
+		initials:     newInitials(rowAvatarSize),
+		title:        widget.NewRichText(),
+		phone:        widget.NewRichText(),
+		organization: widget.NewRichText(),
+		birthday:     newBadge(),
+		favorite:     widget.NewIcon(resources.FavoriteIcon),
+		onTapped:     onTapped,
+		onChecked:    onChecked,
+	}
+	row.image.FillMode = canvas.ImageFillContain
+	row.image.SetMinSize(rowAvatarSize)
+
+	row.title.Truncation = fyne.TextTruncateEllipsis
+	row.phone.Truncation = fyne.TextTruncateEllipsis
+	row.organization.Truncation = fyne.TextTruncateEllipsis
+
+	row.check = widget.NewCheck("", func(checked bool) {
+		row.onChecked(row.id, checked)
+	})
+
+	row.ExtendBaseWidget(row)
+
+	return row
+}
+
+func (r *contactRow) CreateRenderer() fyne.WidgetRenderer {
+	// Две строки текста стоят плотнее, чем с отступами по умолчанию
+	text := container.New(&compactVBox{}, r.title, r.phone)
+
+	// Колонка фиксированной ширины, название по центру по высоте
+	organization := container.New(
+		layout.NewGridWrapLayout(rowOrganizationSize),
+		container.NewVBox(layout.NewSpacer(), r.organization, layout.NewSpacer()),
+	)
+
+	return widget.NewSimpleRenderer(container.NewBorder(
+		nil,
+		nil,
+		container.NewHBox(r.check, container.NewCenter(container.NewStack(r.image, r.initials))),
+		container.NewHBox(organization, container.NewCenter(r.birthday), r.favorite),
+		text,
+	))
+}
+
+// setAvatar – фото контакта, без фото – инициалы
+func (r *contactRow) setAvatar(resource fyne.Resource, hasPhoto bool, initials string) {
+	if hasPhoto {
+		r.image.Resource = resource
+		r.image.Show()
+		r.initials.Hide()
+	} else {
+		r.image.Resource = nil
+		r.image.Hide()
+		r.initials.SetText(initials)
+		r.initials.Show()
+	}
+	r.image.Refresh()
+}
+
+// setTitle – полное имя, совпадения с поисковым запросом выделены
+func (r *contactRow) setTitle(title, query string) {
+	segments := make([]widget.RichTextSegment, 0)
+
+	offset := 0
+	for _, matched := range match.Ranges(title, query) {
+		if matched.Start > offset {
+			segments = append(segments, &widget.TextSegment{
+				Text:  title[offset:matched.Start],
+				Style: inlineStyle(widget.RichTextStyleInline),
+			})
+		}
+
+		highlighted := inlineStyle(widget.RichTextStyleStrong)
+		highlighted.ColorName = theme.ColorNamePrimary
+		segments = append(segments, &widget.TextSegment{
+			Text:  title[matched.Start:matched.End],
+			Style: highlighted,
+		})
+
+		offset = matched.End
+	}
+
+	if offset < len(title) {
+		segments = append(segments, &widget.TextSegment{
+			Text:  title[offset:],
+			Style: inlineStyle(widget.RichTextStyleInline),
+		})
+	}
+
+	r.title.Segments = segments
+	r.title.Refresh()
+}
+
+// setPhone – телефон мелким приглушенным шрифтом под именем
+func (r *contactRow) setPhone(phone string) {
+	style := inlineStyle(widget.RichTextStyleInline)
+	style.ColorName = theme.ColorNamePlaceHolder
+	style.SizeName = theme.SizeNameCaptionText
+
+	r.phone.Segments = []widget.RichTextSegment{
+		&widget.TextSegment{Text: phone, Style: style},
+	}
+	r.phone.Refresh()
+}
+
+// setOrganization – название организации мелким приглушенным шрифтом, пустое – колонка пустая
+func (r *contactRow) setOrganization(organization string) {
+	style := inlineStyle(widget.RichTextStyleInline)
+	style.ColorName = theme.ColorNamePlaceHolder
+	style.SizeName = theme.SizeNameCaptionText
+
+	r.organization.Segments = []widget.RichTextSegment{
+		&widget.TextSegment{Text: organization, Style: style},
+	}
+	r.organization.Refresh()
+}
+
+// inlineStyle – стиль сегмента, который продолжает строку, а не начинает абзац
+func inlineStyle(style widget.RichTextStyle) widget.RichTextStyle {
+	style.Inline = true
+
+	return style
+}
+
+func (r *contactRow) MouseDown(event *desktop.MouseEvent) {
+	r.modifier = event.Modifier
+}
+
+func (r *contactRow) MouseUp(*desktop.MouseEvent) {}
+
+func (r *contactRow) Tapped(*fyne.PointEvent) {
+	modifier := r.modifier
+	r.modifier = 0
+
+	r.onTapped(r.id, modifier)
+}
+
+// compactVBox – вертикальная раскладка без промежутков между строками
+type compactVBox struct{}
+
+func (l *compactVBox) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	y := float32(0)
+	for _, object := range objects {
+		height := object.MinSize().Height
+		object.Move(fyne.NewPos(0, y))
+		object.Resize(fyne.NewSize(size.Width, height))
+		y += height
+	}
+}
+
+func (l *compactVBox) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	size := fyne.NewSize(0, 0)
+	for _, object := range objects {
+		minSize := object.MinSize()
+		size.Width = fyne.Max(size.Width, minSize.Width)
+		size.Height += minSize.Height
+	}
+
+	return size
+}
+
+// contactInitials – первые буквы фамилии и имени
+func contactInitials(contact model.Contact) string {
+	var letters []rune
+	for _, part := range []string{contact.Surname, contact.Name} {
+		for _, r := range strings.TrimSpace(part) {
+			letters = append(letters, unicode.ToUpper(r))
+			break
+		}
+	}
+
+	return string(letters)
+}
+
+// presentPhone – телефон для строки списка, пустой номер не показываем
+func presentPhone(contact model.Contact) string {
+	if contact.Phone.Number() == 0 {
+		return ""
+	}
+
+	return phone.Present(contact.Phone.Number())
+}
@@ -0,0 +1,155 @@
+package contacts_list
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// selectTapped – выбор щелчком по строке.
+//
+// Ctrl (Cmd на macOS) добавляет или убирает контакт из выбранных,
+// Shift выбирает диапазон от последнего выбранного, без модификаторов – только этот контакт.
+func (b *Builder) selectTapped(id widget.ListItemID, modifier fyne.KeyModifier) {
+	b.focus(b.contactsList)
+
+	switch {
+	case modifier&fyne.KeyModifierShift != 0 && b.selectedID >= 0:
+		from, to := min(b.selectedID, id), max(b.selectedID, id)
+
+		b.selected = make(map[string]struct{}, to-from+1)
+		for i := from; i <= to; i++ {
+			if contact, ok := b.contactAt(i); ok {
+				b.selected[contact.UUID] = struct{}{}
+			}
+		}
+
+		// Следующий диапазон считается от того же контакта
+		b.selectionChanged()
+	case modifier&(fyne.KeyModifierControl|fyne.KeyModifierSuper) != 0:
+		b.toggle(id)
+	default:
+		// Повторный выбор того же контакта список не замечает, поэтому сначала снимаем его
+		b.contactsList.Unselect(id)
+		b.contactsList.Select(id)
+	}
+}
+
+// toggle – добавить контакт к выбранным или убрать его
+func (b *Builder) toggle(id widget.ListItemID) {
+	contact, ok := b.contactAt(id)
+	if !ok {
+		return
+	}
+	uuid := contact.UUID
+
+	if _, ok := b.selected[uuid]; ok {
+		delete(b.selected, uuid)
+	} else {
+		b.selected[uuid] = struct{}{}
+	}
+
+	b.selectedID = id
+	b.selectionChanged()
+}
+
+// checkRow – флажок в строке работает как щелчок с Ctrl
+func (b *Builder) checkRow(id widget.ListItemID, checked bool) {
+	contact, ok := b.contactAt(id)
+	if !ok {
+		return
+	}
+
+	if _, ok = b.selected[contact.UUID]; ok == checked {
+		return
+	}
+
+	b.toggle(id)
+}
+
+// checkAll – флажок "Выбрать все" над списком
+func (b *Builder) checkAll(checked bool) {
+	if checked == b.allSelected() {
+		return
+	}
+
+	b.selected = make(map[string]struct{}, len(b.filtered))
+	if checked {
+		for _, contact := range b.filtered {
+			b.selected[contact.UUID] = struct{}{}
+		}
+	}
+
+	b.selectionChanged()
+}
+
+func (b *Builder) allSelected() bool {
+	return len(b.filtered) > 0 && len(b.selected) == len(b.filtered)
+}
+
+// selectionChanged – обновляет список и карточку после изменения выбора.
+//
+// Один контакт показывается карточкой, несколько – сводкой с именами.
+func (b *Builder) selectionChanged() {
+	b.selectAll.SetChecked(b.allSelected())
+
+	if len(b.selected) == 1 {
+		for uuid := range b.selected {
+			if id := b.itemOf(uuid); id >= 0 {
+				b.contactsList.UnselectAll()
+				b.contactsList.Select(id)
+			}
+		}
+
+		b.contactsList.Refresh()
+		return
+	}
+
+	// Подсветка списка показывает только один контакт, при множественном выборе остаются флажки
+	b.contactsList.UnselectAll()
+	b.selectedContact = nil
+	b.contactsList.Refresh()
+
+	if len(b.selected) == 0 {
+		b.contactInfoBox.Objects = nil
+		b.contactInfoBox.Refresh()
+		return
+	}
+
+	b.showSummary()
+}
+
+// showSummary – вместо карточки: сколько контактов выбрано и кто
+func (b *Builder) showSummary() {
+	names := make([]string, 0, len(b.selected))
+	for _, contact := range b.filtered {
+		if _, ok := b.selected[contact.UUID]; ok {
+			names = append(names, strings.TrimSpace(contact.Surname+" "+contact.Name))
+		}
+	}
+
+	title := widget.NewLabel(b.localizer.Plural("list.selected", len(names), nil))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	list := widget.NewLabel(strings.Join(names, "\n"))
+	list.Wrapping = fyne.TextWrapWord
+
+	b.contactInfoBox.Objects = []fyne.CanvasObject{
+		container.NewBorder(title, nil, nil, nil, list),
+	}
+	b.contactInfoBox.Refresh()
+}
+
+// SelectedContactUUIDs – выбранные контакты в порядке списка
+func (b *Builder) SelectedContactUUIDs() []string {
+	uuids := make([]string, 0, len(b.selected))
+	for _, contact := range b.filtered {
+		if _, ok := b.selected[contact.UUID]; ok {
+			uuids = append(uuids, contact.UUID)
+		}
+	}
+
+	return uuids
+}
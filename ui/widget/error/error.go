@@ -1,61 +1,82 @@
 package error
 
 import (
+	"sort"
+	"strings"
+
 	"fyne.io/fyne/v2/widget"
 
-	contactsDomain "contacts/internal/domain/contacts"
 	"contacts/internal/model"
 	"contacts/ui/dto"
 )
 
-var allowedLinks = contactsDomain.AllowedLinks()
-
-func Show(fieldMsgs map[model.Field]string, contactInfoWidget *dto.ContactInfoWidget, errorLabel *widget.Label) {
-	var messageToShow *string
-
-	// Базовые поля
-	for field, message := range fieldMsgs {
-		messageToShow = &message
-
-		switch field {
-		case model.FieldName:
-			contactInfoWidget.AssignedByLabel["Name"].Label.Importance = widget.DangerImportance
-		case model.FieldSurname:
-			contactInfoWidget.AssignedByLabel["Surname"].Label.Importance = widget.DangerImportance
-		case model.FieldBirthday:
-			contactInfoWidget.AssignedByLabel["Birthday"].Label.Importance = widget.DangerImportance
-		case model.FieldEmail:
-			contactInfoWidget.AssignedByLabel["Email"].Label.Importance = widget.DangerImportance
-		case model.FieldPhone:
-			contactInfoWidget.AssignedByLabel["Phone"].Label.Importance = widget.DangerImportance
-		}
+type localizer interface {
+	Message(message model.Message) string
+}
+
+// Show – сообщения об ошибках под соответствующими полями.
+//
+// Сообщения для полей, которых нет в форме, показываются в errorLabel.
+func Show(
+	fieldMsgs map[model.Field]model.Message,
+	localizer localizer,
+	contactInfoWidget *dto.ContactInfoWidget,
+	errorLabel *widget.Label,
+) {
+	// Обходим поля в стабильном порядке, чтобы общие сообщения не прыгали
+	fields := make([]string, 0, len(fieldMsgs))
+	for field := range fieldMsgs {
+		fields = append(fields, string(field))
 	}
+	sort.Strings(fields)
 
-	// Ссылки
-	for _, link := range allowedLinks {
-		contactWidgetRow, ok := contactInfoWidget.AssignedByLabel[string(link)]
-		if !ok {
-			continue
-		}
+	var withoutRow []string
+
+	for _, field := range fields {
+		message := localizer.Message(fieldMsgs[model.Field(field)])
 
-		msg, ok := fieldMsgs[model.Field(link)]
-		if !ok {
+		contactWidgetRow, ok := contactInfoWidget.AssignedByField[model.Field(field)]
+		if !ok || contactWidgetRow.Error == nil {
+			withoutRow = append(withoutRow, message)
 			continue
 		}
 
-		messageToShow = &msg
 		contactWidgetRow.Label.Importance = widget.DangerImportance
-	}
+		contactWidgetRow.Label.Refresh()
 
-	// Обновим стейт всех лейблов
-	for _, contactInfoWidgetRow := range contactInfoWidget.AssignedByLabel {
-		contactInfoWidgetRow.Label.Refresh()
+		contactWidgetRow.Error.SetText(message)
+		contactWidgetRow.Error.Show()
 	}
 
-	if messageToShow == nil {
+	if len(withoutRow) == 0 {
 		return
 	}
 
-	errorLabel.SetText(*messageToShow)
+	errorLabel.SetText(strings.Join(withoutRow, "\n"))
 	errorLabel.Show()
 }
+
+// Clear – скрывает все сообщения об ошибках и возвращает подписям обычный цвет
+func Clear(contactInfoWidget *dto.ContactInfoWidget, errorLabel *widget.Label) {
+	errorLabel.Hide()
+
+	for _, contactWidgetRow := range contactInfoWidget.AssignedByField {
+		contactWidgetRow.Label.Importance = widget.MediumImportance
+		contactWidgetRow.Label.Refresh()
+
+		if contactWidgetRow.Error != nil {
+			contactWidgetRow.Error.Hide()
+		}
+	}
+}
+
+// Join – все сообщения об ошибках одной строкой, в стабильном порядке
+func Join(fieldMsgs map[model.Field]model.Message, localizer localizer) string {
+	messages := make([]string, 0, len(fieldMsgs))
+	for _, message := range fieldMsgs {
+		messages = append(messages, localizer.Message(message))
+	}
+	sort.Strings(messages)
+
+	return strings.Join(messages, "\n")
+}
@@ -0,0 +1,90 @@
+package error
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+	"contacts/ui/dto"
+	"contacts/util/debounce"
+)
+
+// Пауза в наборе, после которой поле проверяется
+const validateDelay = 300 * time.Millisecond
+
+type validator interface {
+	ValidateField(field model.Field, value string) (model.Message, bool)
+}
+
+// Watch – проверка полей формы во время ввода.
+//
+// Сообщение показывается под полем после паузы в наборе, нетронутые поля не подсвечиваются.
+// onValidity вызывается при каждом изменении с признаком, что вся форма корректна.
+func Watch(
+	contactInfoWidget *dto.ContactInfoWidget,
+	validator validator,
+	localizer localizer,
+	onValidity func(valid bool),
+) {
+	var mu sync.Mutex
+
+	// Корректность каждого поля, форма валидна, когда корректны все
+	valid := make(map[model.Field]bool, len(contactInfoWidget.AssignedByField))
+
+	notify := func() {
+		mu.Lock()
+		allValid := true
+		for _, ok := range valid {
+			allValid = allValid && ok
+		}
+		mu.Unlock()
+
+		onValidity(allValid)
+	}
+
+	for field, row := range contactInfoWidget.AssignedByField {
+		// Фото не проверяется и ошибки под ним нет
+		if row.Error == nil || row.Entry == nil {
+			continue
+		}
+
+		field, row := field, row
+
+		_, ok := validator.ValidateField(field, row.Entry.Text)
+		valid[field] = ok
+
+		debouncer := debounce.New(validateDelay)
+
+		row.Entry.OnChanged = func(value string) {
+			debouncer.Call(func() {
+				msg, ok := validator.ValidateField(field, value)
+
+				mu.Lock()
+				valid[field] = ok
+				mu.Unlock()
+
+				showField(row, localizer, msg, ok)
+				notify()
+			})
+		}
+	}
+
+	notify()
+}
+
+// showField – сообщение под одним полем или его скрытие
+func showField(row dto.ContactWidgetRow, localizer localizer, msg model.Message, ok bool) {
+	if ok {
+		row.Label.Importance = widget.MediumImportance
+		row.Label.Refresh()
+		row.Error.Hide()
+		return
+	}
+
+	row.Label.Importance = widget.DangerImportance
+	row.Label.Refresh()
+	row.Error.SetText(localizer.Message(msg))
+	row.Error.Show()
+}
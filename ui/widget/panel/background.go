@@ -0,0 +1,63 @@
+package panel
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Прозрачность акцентного цвета в подложке панели
+const backgroundAlpha = 0x40
+
+// Background – скругленная подложка панели в акцентном цвете темы, общая для панелей главного окна.
+//
+// Обычный canvas.Rectangle не перечитывает цвет при смене темы,
+// а виджет перерисовывается вместе с остальным интерфейсом.
+type Background struct {
+	widget.BaseWidget
+}
+
+func NewBackground() *Background {
+	b := &Background{}
+	b.ExtendBaseWidget(b)
+
+	return b
+}
+
+func (b *Background) CreateRenderer() fyne.WidgetRenderer {
+	rectangle := canvas.NewRectangle(color.Transparent)
+
+	r := &backgroundRenderer{rectangle: rectangle}
+	r.Refresh()
+
+	return r
+}
+
+type backgroundRenderer struct {
+	rectangle *canvas.Rectangle
+}
+
+func (r *backgroundRenderer) Layout(size fyne.Size) {
+	r.rectangle.Resize(size)
+}
+
+func (r *backgroundRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *backgroundRenderer) Refresh() {
+	red, green, blue, _ := theme.Color(theme.ColorNamePrimary).RGBA()
+
+	r.rectangle.FillColor = color.NRGBA{R: uint8(red >> 8), G: uint8(green >> 8), B: uint8(blue >> 8), A: backgroundAlpha}
+	r.rectangle.CornerRadius = theme.InputRadiusSize() * 2
+	r.rectangle.Refresh()
+}
+
+func (r *backgroundRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.rectangle}
+}
+
+func (r *backgroundRenderer) Destroy() {}
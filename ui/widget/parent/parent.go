@@ -0,0 +1,21 @@
+package parent
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// Window – окно, в котором показан объект, nil – объект еще не на экране
+func Window(object fyne.CanvasObject) fyne.Window {
+	canvas := fyne.CurrentApp().Driver().CanvasForObject(object)
+	if canvas == nil {
+		return nil
+	}
+
+	for _, window := range fyne.CurrentApp().Driver().AllWindows() {
+		if window.Canvas() == canvas {
+			return window
+		}
+	}
+
+	return nil
+}
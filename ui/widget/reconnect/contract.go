@@ -0,0 +1,30 @@
+package reconnect
+
+import (
+	"context"
+	"time"
+
+	"contacts/internal/model"
+)
+
+type interactionHandler interface {
+	Due(ctx context.Context) ([]model.ReconnectDue, error)
+}
+
+type contactList interface {
+	Open(uuid string)
+}
+
+type reporter interface {
+	Log(operation string, err error, attrs ...any)
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+	Plural(id string, count int, params map[string]any) string
+}
+
+type dates interface {
+	Format(t time.Time) string
+}
@@ -0,0 +1,177 @@
+package reconnect
+
+import (
+	"context"
+	"image/color"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+	"contacts/ui/widget/panel"
+)
+
+var (
+	iconSize = fyne.NewSize(40, 40)
+	// Минимальная высота списка, чтобы панель не схлопывалась
+	listMinHeight float32 = 120
+)
+
+type Builder struct {
+	interactionHandler interactionHandler
+	contactList        contactList
+	reporter           reporter
+	localizer          localizer
+	dates              dates
+
+	// Для хранения стейта
+	mu        sync.Mutex
+	due       []model.ReconnectDue
+	list      *widget.List
+	emptyText *widget.Label
+}
+
+func NewBuilder(
+	interactionHandler interactionHandler,
+	contactList contactList,
+	reporter reporter,
+	localizer localizer,
+	dates dates,
+) *Builder {
+	return &Builder{
+		interactionHandler: interactionHandler,
+		contactList:        contactList,
+		reporter:           reporter,
+		localizer:          localizer,
+		dates:              dates,
+	}
+}
+
+// Build – панель с контактами, с которыми пора связаться.
+//
+// Выбор строки открывает контакт в списке, чтобы сразу записать общение.
+func (b *Builder) Build() *fyne.Container {
+	icon := widget.NewIcon(theme.MailSendIcon())
+
+	infoText := widget.NewLabelWithStyle(b.localizer.T("reconnect.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+
+	// Текст, который показывается вместо пустого списка или при ошибке
+	b.emptyText = widget.NewLabel("")
+	b.emptyText.Hide()
+
+	b.list = widget.NewList(
+		func() int {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			return len(b.due)
+		},
+		func() fyne.CanvasObject {
+			return widget.NewLabel("")
+		},
+		func(id int, obj fyne.CanvasObject) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if id >= len(b.due) {
+				return
+			}
+			obj.(*widget.Label).SetText(b.present(b.due[id]))
+		},
+	)
+	b.list.OnSelected = func(id widget.ListItemID) {
+		b.mu.Lock()
+		var uuid string
+		if id < len(b.due) {
+			uuid = b.due[id].Contact.UUID
+		}
+		b.mu.Unlock()
+
+		b.list.Unselect(id)
+		if uuid != "" {
+			b.contactList.Open(uuid)
+		}
+	}
+
+	// Прозрачная подложка задает минимальную высоту списка
+	listMinSize := canvas.NewRectangle(color.Transparent)
+	listMinSize.SetMinSize(fyne.NewSize(0, listMinHeight))
+
+	header := container.NewHBox(
+		container.NewGridWrap(iconSize, icon),
+		container.NewCenter(infoText),
+	)
+
+	content := container.NewBorder(
+		header,
+		nil,
+		nil,
+		nil,
+		container.NewStack(listMinSize, b.list, b.emptyText),
+	)
+
+	b.Refresh()
+
+	return container.NewStack(panel.NewBackground(), container.NewPadded(content))
+}
+
+// Refresh – перечитать, с кем пора связаться, например после записи общения в карточке
+func (b *Builder) Refresh() {
+	due, err := b.interactionHandler.Due(context.Background())
+	if err != nil {
+		b.reporter.Log("reconnect.load", err)
+
+		b.set(nil, b.localizer.T("reconnect.error"))
+		return
+	}
+
+	b.Set(due)
+}
+
+// Set – показать готовый список, например из задачи напоминаний
+func (b *Builder) Set(due []model.ReconnectDue) {
+	b.set(due, b.localizer.T("reconnect.empty"))
+}
+
+func (b *Builder) set(due []model.ReconnectDue, emptyMessage string) {
+	if b.list == nil {
+		return
+	}
+
+	b.mu.Lock()
+	b.due = due
+	b.mu.Unlock()
+
+	b.emptyText.SetText(emptyMessage)
+	if len(due) > 0 {
+		b.emptyText.Hide()
+	} else {
+		b.emptyText.Show()
+	}
+	b.list.Refresh()
+}
+
+// present – строка вида "Ершов Виталий – последний раз 02.10.2026, просрочено на 10 дней"
+func (b *Builder) present(due model.ReconnectDue) string {
+	params := map[string]any{
+		"Surname": due.Contact.Surname,
+		"Name":    due.Contact.Name,
+	}
+
+	if due.LastContacted.IsZero() {
+		return b.localizer.Format("reconnect.row.never", params)
+	}
+
+	params["Date"] = b.dates.Format(due.LastContacted)
+	if due.DaysOverdue == 0 {
+		return b.localizer.Format("reconnect.row.today", params)
+	}
+
+	params["Overdue"] = b.localizer.Plural("reconnect.overdue", due.DaysOverdue, nil)
+
+	return b.localizer.Format("reconnect.row", params)
+}
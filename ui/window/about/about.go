@@ -1,15 +1,19 @@
 package about
 
 import (
-	"image/color"
+	"net/url"
 
 	"fyne.io/fyne/v2"
-	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/ui/shortcut"
 )
 
+// Версия для сборки без fyne package, когда метаданных FyneApp.toml нет
 const (
-	versionText = "v. 1.0.0"
+	fallbackVersion = "1.0.0"
 )
 
 var (
@@ -17,118 +21,91 @@ var (
 )
 
 type Builder struct {
-	app app
+	app       app
+	localizer localizer
 }
 
-func NewBuilder(app app) *Builder {
+func NewBuilder(app app, localizer localizer) *Builder {
 	return &Builder{
-		app: app,
+		app:       app,
+		localizer: localizer,
 	}
 }
 
 func (b *Builder) Build() fyne.Window {
 	// Конфигурация нового окна
-	window := b.app.NewWindow("About")
+	window := b.app.NewWindow(b.localizer.T("about.title"))
 	window.Resize(windowSize)
-	window.SetFixedSize(true)
 	window.CenterOnScreen()
+	shortcut.CloseOnEscape(window)
 
 	// Компоненты окна
-	title := canvas.NewText("ContactsApp", color.Black)
-	title.TextSize = 24
-	title.TextStyle = fyne.TextStyle{Bold: true}
-
-	version := canvas.NewText(versionText, color.Black)
-	version.TextSize = 14
-	version.Move(fyne.NewPos(0, title.Position().Y+title.TextSize+10))
+	title := widget.NewRichTextFromMarkdown("# ContactsApp")
 
-	links := buildLinks()
-	links.Move(fyne.NewPos(0, version.Position().Y+version.TextSize+25))
+	version := widget.NewLabel("v. " + b.version())
 
-	footer := canvas.NewText("2024 Ershov V.A.", color.Black)
-	footer.TextSize = 14
-	footer.Move(fyne.NewPos(0, windowSize.Height-30))
+	footer := widget.NewLabel("2024 Ershov V.A.")
 
-	box := container.NewWithoutLayout(
-		title,
-		version,
-		links,
+	window.SetContent(container.NewBorder(
+		container.NewVBox(title, version),
 		footer,
-	)
-
-	window.SetContent(box)
+		nil,
+		nil,
+		container.NewVBox(b.buildLinks(), layout.NewSpacer()),
+	))
 
 	return window
 }
 
-// buildLinks – формирует строки вида Author: Ershov Vitaliy
-//
-// y – с какой позиции по y начинать список
-// spacing – расстояние между элементами списка
-func buildLinks() *fyne.Container {
-	type link struct {
-		setRedirectStyle bool
-		url              string
+// version – версия из метаданных сборки
+func (b *Builder) version() string {
+	if version := b.app.Metadata().Version; version != "" {
+		return version
 	}
 
+	return fallbackVersion
+}
+
+// buildLinks – формирует строки вида Author: Ershov Vitaliy
+func (b *Builder) buildLinks() fyne.CanvasObject {
 	type row struct {
 		label string
-		link  link
+		text  string
+		url   string
 	}
 
 	rows := []row{
 		{
-			label: "Author:",
-			link: link{
-				url: "Ershov V.A.",
-			},
+			label: b.localizer.T("about.author"),
+			text:  "Ershov V.A.",
 		},
-		// Пустая строка
-		{},
 		{
-			label: "email for feedback:",
-			link: link{
-				setRedirectStyle: true,
-				url:              "vaershov@avito.ru",
-			},
+			label: b.localizer.T("about.feedback"),
+			text:  "vaershov@avito.ru",
+			url:   "mailto:vaershov@avito.ru",
 		},
 		{
-			label: "github:",
-			link: link{
-				setRedirectStyle: true,
-				url:              "https://github.com/LaHainee/syn_lab",
-			},
+			label: b.localizer.T("about.github"),
+			text:  "https://github.com/LaHainee/syn_lab",
+			url:   "https://github.com/LaHainee/syn_lab",
 		},
 	}
 
-	vertical := container.NewVBox()
+	form := container.New(layout.NewFormLayout())
 
 	for _, r := range rows {
-		labelText := canvas.NewText(r.label, color.Black)
-		labelText.TextSize = 14
-
-		linkText := canvas.NewText(r.link.url, color.Black)
-		linkText.TextSize = 14
-
-		linkBox := container.NewWithoutLayout()
-		linkBox.Add(linkText)
+		var value fyne.CanvasObject = widget.NewLabel(r.text)
 
-		// Если ссылку требуется стилизовать
-		if r.link.setRedirectStyle {
-			linkText.Color = color.RGBA{B: 255, A: 255}
-
-			// В fyne не поддерживается подчеркнутый текст, поэтому костылим свою линию
-			line := canvas.NewLine(color.RGBA{B: 255, A: 255})
-			lineY := linkText.TextSize + 5
-			line.Position1 = fyne.NewPos(0, lineY)
-			line.Position2 = fyne.NewPos(linkBox.MinSize().Width, lineY)
-			linkBox.Add(line)
+		// Ссылки открываются в браузере или почтовом клиенте
+		if r.url != "" {
+			if parsed, err := url.Parse(r.url); err == nil {
+				value = widget.NewHyperlink(r.text, parsed)
+			}
 		}
 
-		horizontal := container.NewHBox(labelText, linkBox)
-
-		vertical.Add(horizontal)
+		form.Add(widget.NewLabel(r.label))
+		form.Add(value)
 	}
 
-	return vertical
+	return form
 }
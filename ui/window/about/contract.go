@@ -4,4 +4,9 @@ import "fyne.io/fyne/v2"
 
 type app interface {
 	NewWindow(title string) fyne.Window
+	Metadata() fyne.AppMetadata
+}
+
+type localizer interface {
+	T(id string) string
 }
@@ -2,6 +2,7 @@ package create_contact
 
 import (
 	"context"
+	"time"
 
 	"fyne.io/fyne/v2"
 
@@ -16,6 +17,36 @@ type contactList interface {
 	Refresh()
 }
 
+type avatarBuilder interface {
+	Resource(hash string) fyne.Resource
+	Pick(window fyne.Window, onPicked func(hash string))
+}
+
 type createHandler interface {
-	Create(ctx context.Context, contact model.ContactForCreate) (map[model.Field]string, error)
+	Create(ctx context.Context, contact model.ContactForCreate) (map[model.Field]model.Message, error)
+}
+
+type organizationHandler interface {
+	List(ctx context.Context) ([]model.Organization, error)
+}
+
+type validator interface {
+	ValidateField(field model.Field, value string) (model.Message, bool)
+}
+
+type reporter interface {
+	Log(operation string, err error, attrs ...any)
+	Error(window fyne.Window, operation string, err error, retry func(), attrs ...any)
+}
+
+type localizer interface {
+	T(id string) string
+	Message(message model.Message) string
+}
+
+type dates interface {
+	Parse(value string) (time.Time, error)
+	Format(t time.Time) string
+	FormatDateTime(t time.Time) string
+	Example() string
 }
@@ -6,11 +6,14 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
 
 	contactsDomain "contacts/internal/domain/contacts"
 	"contacts/internal/model"
 	"contacts/ui/dto"
+	"contacts/ui/shortcut"
 	wigetContactInfo "contacts/ui/widget/contact_info"
 	errorWidget "contacts/ui/widget/error"
 	"contacts/util/pointer"
@@ -18,113 +21,156 @@ import (
 
 var allowedLinks = contactsDomain.AllowedLinks()
 
+// windowSize – начальный размер окна, дальше окно можно растягивать
+var windowSize = fyne.NewSize(520, 620)
+
 type Builder struct {
-	app           app
-	contactList   contactList
-	createHandler createHandler
+	app                 app
+	contactList         contactList
+	createHandler       createHandler
+	organizationHandler organizationHandler
+	validator           validator
+	avatarBuilder       avatarBuilder
+	reporter            reporter
+	localizer           localizer
+	dates               dates
 }
 
 func NewBuilder(
 	app app,
 	contactList contactList,
 	createHandler createHandler,
+	organizationHandler organizationHandler,
+	validator validator,
+	avatarBuilder avatarBuilder,
+	reporter reporter,
+	localizer localizer,
+	dates dates,
 ) *Builder {
 	return &Builder{
-		app:           app,
-		contactList:   contactList,
-		createHandler: createHandler,
+		app:                 app,
+		contactList:         contactList,
+		createHandler:       createHandler,
+		organizationHandler: organizationHandler,
+		validator:           validator,
+		avatarBuilder:       avatarBuilder,
+		reporter:            reporter,
+		localizer:           localizer,
+		dates:               dates,
 	}
 }
 
 func (b *Builder) Build() fyne.Window {
 	contactInfoWidgetRowsData := []dto.ContactInfoWidgetRowData{
 		{
-			Label: "Surname",
+			Field: model.FieldAvatar,
+			Label: b.localizer.T("field.photo"),
+			Entry: dto.ContactInfoWidgetRowEntry{
+				Type:  dto.ContactWidgetRowTypeAvatar,
+				Image: b.avatarBuilder.Resource(""),
+			},
+		},
+		{
+			Field: model.FieldSurname,
+			Label: b.localizer.T("field.surname"),
 			Entry: dto.ContactInfoWidgetRowEntry{
 				Type:        dto.ContactWidgetRowTypeText,
-				Placeholder: pointer.To("Ершов"),
+				Placeholder: pointer.To(b.localizer.T("placeholder.surname")),
 			},
 		},
 		{
-			Label: "Name",
+			Field: model.FieldName,
+			Label: b.localizer.T("field.name"),
 			Entry: dto.ContactInfoWidgetRowEntry{
 				Type:        dto.ContactWidgetRowTypeText,
-				Placeholder: pointer.To("Виталий"),
+				Placeholder: pointer.To(b.localizer.T("placeholder.name")),
 			},
 		},
 		{
-			Label: "Birthday",
+			Field: model.FieldBirthday,
+			Label: b.localizer.T("field.birthday"),
 			Entry: dto.ContactInfoWidgetRowEntry{
 				Type:        dto.ContactWidgetRowTypeDatePicker,
-				Placeholder: pointer.To("10.01.2001"),
+				Placeholder: pointer.To(b.dates.Example()),
 			},
 		},
 		{
-			Label: "Phone",
+			Field: model.FieldPhone,
+			Label: b.localizer.T("field.phone"),
 			Entry: dto.ContactInfoWidgetRowEntry{
-				Type:        dto.ContactWidgetRowTypeText,
+				Type:        dto.ContactWidgetRowTypePhone,
 				Placeholder: pointer.To("+7 (915) 159-67-81"),
 			},
 		},
 		{
-			Label: "Email",
+			Field: model.FieldEmail,
+			Label: b.localizer.T("field.email"),
 			Entry: dto.ContactInfoWidgetRowEntry{
-				Type:        dto.ContactWidgetRowTypeText,
+				Type:        dto.ContactWidgetRowTypeEmail,
 				Placeholder: pointer.To("vaershov@avito.ru"),
 			},
 		},
 	}
 
+	contactInfoWidgetBuilder := wigetContactInfo.NewBuilder(b.localizer, b.dates)
+
+	contactInfoWidgetRowsData = append(contactInfoWidgetRowsData, contactInfoWidgetBuilder.OrganizationRows(b.organizations(), model.Contact{})...)
+
 	for _, allowedLink := range allowedLinks {
 		contactInfoWidgetRowsData = append(contactInfoWidgetRowsData, dto.ContactInfoWidgetRowData{
+			Field: model.Field(allowedLink),
 			Label: string(allowedLink),
 			Entry: dto.ContactInfoWidgetRowEntry{
-				Type:        dto.ContactWidgetRowTypeText,
+				Type:        dto.ContactWidgetRowTypeLink,
 				Placeholder: pointer.To("https://ya.ru"),
 			},
 		})
 	}
 
-	contactInfoWidgetBuilder := wigetContactInfo.NewBuilder(
-		dto.Position{
-			X: -100,
-			Y: 25,
+	// Заметки в конце формы, после ссылок
+	contactInfoWidgetRowsData = append(contactInfoWidgetRowsData, dto.ContactInfoWidgetRowData{
+		Field: model.FieldNotes,
+		Label: b.localizer.T("field.notes"),
+		Entry: dto.ContactInfoWidgetRowEntry{
+			Type:        dto.ContactWidgetRowTypeNotes,
+			Placeholder: pointer.To(b.localizer.T("placeholder.notes")),
 		},
-		50,
-	)
+	})
 
-	contactInfoWidget := contactInfoWidgetBuilder.Build(contactInfoWidgetRowsData)
+	contactInfoWidget := contactInfoWidgetBuilder.Build(contactInfoWidgetRowsData, true)
 
-	window := b.app.NewWindow("Добавить контакт")
-	window.Resize(fyne.NewSize(contactInfoWidget.Size.Width-75, contactInfoWidget.Size.Height+100))
+	window := b.app.NewWindow(b.localizer.T("contact.create.title"))
+	window.Resize(windowSize)
 	window.CenterOnScreen()
-	window.SetFixedSize(true)
+	shortcut.CloseOnEscape(window)
+
+	// Выбор фото
+	avatarRow := contactInfoWidget.AssignedByField[model.FieldAvatar]
+	avatarRow.Button.OnTapped = func() {
+		b.avatarBuilder.Pick(window, func(hash string) {
+			avatarRow.Entry.SetText(hash)
+			avatarRow.Image.Resource = b.avatarBuilder.Resource(hash)
+			avatarRow.Image.Refresh()
+		})
+	}
 
 	// Форма для отображения текста об ошибке
 	errorLabel := widget.NewLabel("")
-	errorLabel.Resize(fyne.NewSize(contactInfoWidget.Size.Width-50, 50))
-	errorLabel.Move(fyne.NewPos(20, contactInfoWidget.Size.Height-10))
+	errorLabel.Wrapping = fyne.TextWrapWord
 	errorLabel.Hide()
 
-	closeButton := widget.NewButton("Cancel", func() {
+	closeButton := widget.NewButton(b.localizer.T("button.cancel"), func() {
 		window.Close()
 	})
-	closeButton.Resize(fyne.NewSize(70, 30))
-	closeButton.Move(fyne.NewPos(contactInfoWidget.Size.Width-100-closeButton.Size().Width, contactInfoWidget.Size.Height+50))
-
-	confirmButton := widget.NewButton("OK", func() {
-		// Очистим предыдущий стейт:
-		// 1. Скроем сообщения об ошибке
-		// 2. Перекрасим лейблы в черный цвет
-		errorLabel.Hide()
-		for _, contactInfoWidgetRow := range contactInfoWidget.AssignedByLabel {
-			contactInfoWidgetRow.Label.Importance = widget.MediumImportance
-			contactInfoWidgetRow.Label.Refresh()
-		}
+
+	var confirmButton *widget.Button
+	confirmButton = widget.NewButton(b.localizer.T("button.ok"), func() {
+		// Очистим ошибки предыдущей попытки
+		errorWidget.Clear(&contactInfoWidget, errorLabel)
 
 		links := make(map[model.ContactLink]string)
 		for _, link := range allowedLinks {
-			contactWidgetRow, ok := contactInfoWidget.AssignedByLabel[string(link)]
+			contactWidgetRow, ok := contactInfoWidget.AssignedByField[model.Field(link)]
 			if !ok {
 				continue
 			}
@@ -132,36 +178,77 @@ func (b *Builder) Build() fyne.Window {
 		}
 
 		fieldMsgs, err := b.createHandler.Create(context.Background(), model.ContactForCreate{
-			Surname:  contactInfoWidget.AssignedByLabel["Surname"].Entry.Text,
-			Name:     contactInfoWidget.AssignedByLabel["Name"].Entry.Text,
-			Birthday: contactInfoWidget.AssignedByLabel["Birthday"].Entry.Text,
-			Phone:    contactInfoWidget.AssignedByLabel["Phone"].Entry.Text,
-			Email:    contactInfoWidget.AssignedByLabel["Email"].Entry.Text,
+			Surname:  contactInfoWidget.AssignedByField[model.FieldSurname].Entry.Text,
+			Name:     contactInfoWidget.AssignedByField[model.FieldName].Entry.Text,
+			Birthday: contactInfoWidget.AssignedByField[model.FieldBirthday].Entry.Text,
+			Phone:    contactInfoWidget.AssignedByField[model.FieldPhone].Entry.Text,
+			Email:    contactInfoWidget.AssignedByField[model.FieldEmail].Entry.Text,
 			Links:    links,
+			Avatar:   avatarRow.Entry.Text,
+			Notes:    contactInfoWidget.AssignedByField[model.FieldNotes].Entry.Text,
+
+			Organization: contactInfoWidget.AssignedByField[model.FieldOrganization].Entry.Text,
+			Department:   contactInfoWidget.AssignedByField[model.FieldDepartment].Entry.Text,
+			Title:        contactInfoWidget.AssignedByField[model.FieldTitle].Entry.Text,
 		})
 		if err != nil {
 			if errors.Is(err, model.ErrValidation) {
-				errorWidget.Show(fieldMsgs, &contactInfoWidget, errorLabel)
+				errorWidget.Show(fieldMsgs, b.localizer, &contactInfoWidget, errorLabel)
+				return
+			}
+
+			// Контакт сохранен, но телефон или email уже есть у другого контакта
+			if errors.Is(err, model.ErrUniqueWarning) {
+				b.contactList.Refresh()
+
+				errorWidget.Show(fieldMsgs, b.localizer, &contactInfoWidget, errorLabel)
+
+				warning := dialog.NewInformation(b.localizer.T("contact.saved.title"), errorWidget.Join(fieldMsgs, b.localizer), window)
+				warning.SetOnClosed(window.Close)
+				warning.Show()
 				return
 			}
 
-			panic(err)
+			b.reporter.Error(window, "contact.create", err, confirmButton.OnTapped)
+			return
 		}
 
 		b.contactList.Refresh()
 
 		window.Close()
 	})
-	confirmButton.Resize(fyne.NewSize(70, 30))
-	confirmButton.Move(fyne.NewPos(closeButton.Position().X-25-confirmButton.Size().Width, contactInfoWidget.Size.Height+50))
+	confirmButton.Importance = widget.HighImportance
+
+	// Поля проверяются во время ввода, OK доступна только для корректной формы
+	errorWidget.Watch(&contactInfoWidget, b.validator, b.localizer, func(valid bool) {
+		if valid {
+			confirmButton.Enable()
+		} else {
+			confirmButton.Disable()
+		}
+	})
 
-	box := container.NewWithoutLayout()
-	box.Add(contactInfoWidget.Box)
-	box.Add(closeButton)
-	box.Add(confirmButton)
-	box.Add(errorLabel)
+	// Форма прокручивается, ошибка и кнопки всегда остаются внизу окна
+	buttons := container.NewHBox(layout.NewSpacer(), confirmButton, closeButton)
 
-	window.SetContent(box)
+	window.SetContent(container.NewBorder(
+		nil,
+		container.NewVBox(errorLabel, buttons),
+		nil,
+		nil,
+		container.NewVScroll(contactInfoWidget.Box),
+	))
 
 	return window
 }
+
+// organizations – организации для выбора в форме, без них форма работает, но без подсказок
+func (b *Builder) organizations() []model.Organization {
+	organizations, err := b.organizationHandler.List(context.Background())
+	if err != nil {
+		b.reporter.Log("organizations.load", err)
+		return nil
+	}
+
+	return organizations
+}
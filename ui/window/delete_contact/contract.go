@@ -18,8 +18,20 @@ type contactList interface {
 
 type deleteHandler interface {
 	Delete(ctx context.Context, uuid string) error
+	DeleteMany(ctx context.Context, uuids []string) error
 }
 
 type fetchHandler interface {
+	Fetch(ctx context.Context) ([]model.Contact, error)
 	FetchByUuid(ctx context.Context, uuid string) (model.Contact, error)
 }
+
+type reporter interface {
+	Error(window fyne.Window, operation string, err error, retry func(), attrs ...any)
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+	Plural(id string, count int, params map[string]any) string
+}
@@ -2,16 +2,20 @@ package delete_contact
 
 import (
 	"context"
-	"fmt"
+	"slices"
+	"strings"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
 )
 
 var (
-	windowSize = fyne.NewSize(300, 100)
-	buttonSize = fyne.NewSize(70, 30)
+	windowSize     = fyne.NewSize(320, 120)
+	manyWindowSize = fyne.NewSize(360, 320)
 )
 
 type Builder struct {
@@ -19,6 +23,8 @@ type Builder struct {
 	deleteHandler deleteHandler
 	fetchHandler  fetchHandler
 	contactList   contactList
+	reporter      reporter
+	localizer     localizer
 }
 
 func NewBuilder(
@@ -26,63 +32,182 @@ func NewBuilder(
 	deleteHandler deleteHandler,
 	fetchHandler fetchHandler,
 	contactList contactList,
+	reporter reporter,
+	localizer localizer,
 ) *Builder {
 	return &Builder{
 		app:           app,
 		deleteHandler: deleteHandler,
 		fetchHandler:  fetchHandler,
 		contactList:   contactList,
+		reporter:      reporter,
+		localizer:     localizer,
 	}
 }
 
+// Build – окно подтверждения удаления.
+//
+// Если контакт не удалось прочитать, ошибка показывается в главном окне и возвращается nil.
 func (b *Builder) Build(contactUuid string) fyne.Window {
 	contact, err := b.fetchHandler.FetchByUuid(context.Background(), contactUuid)
 	if err != nil {
-		panic(err)
+		b.reporter.Error(nil, "contact.fetch", err, func() {
+			if window := b.Build(contactUuid); window != nil {
+				window.Show()
+			}
+		}, "uuid", contactUuid)
+		return nil
 	}
 
-	window := b.app.NewWindow("Delete contact")
+	window := b.app.NewWindow(b.localizer.T("contact.delete.title"))
 	window.Resize(windowSize)
-	window.SetFixedSize(true)
 	window.CenterOnScreen()
 
-	label := widget.NewLabel(fmt.Sprintf("Удалить контакт %s %s?", contact.Name, contact.Surname))
+	label := widget.NewLabel(b.localizer.Format("contact.delete.confirm", map[string]any{
+		"Name":    contact.Name,
+		"Surname": contact.Surname,
+	}))
+	label.Wrapping = fyne.TextWrapWord
+
+	content := container.NewVBox(label)
+
+	// Связи нужны только для предупреждения, без них окно все равно показываем
+	contacts, err := b.fetchHandler.Fetch(context.Background())
+	if err == nil {
+		if warning := b.relationsWarning(contacts, []string{contactUuid}); warning != nil {
+			content.Add(warning)
+		}
+	}
 
-	closeButton := widget.NewButton("Cancel", func() {
+	closeButton := widget.NewButton(b.localizer.T("button.cancel"), func() {
 		window.Close()
 	})
-	closeButton.Resize(buttonSize)
-	closeButton.Move(
-		fyne.NewPos(
-			windowSize.Width-buttonSize.Width-20,
-			windowSize.Height-buttonSize.Height-20,
-		),
-	)
-
-	confirmButton := widget.NewButton("OK", func() {
+
+	var confirmButton *widget.Button
+	confirmButton = widget.NewButton(b.localizer.T("button.ok"), func() {
 		err = b.deleteHandler.Delete(context.Background(), contactUuid)
 		if err != nil {
-			panic(err)
+			b.reporter.Error(window, "contact.delete", err, confirmButton.OnTapped, "uuid", contactUuid)
+			return
+		}
+
+		b.contactList.Refresh()
+
+		window.Close()
+	})
+	confirmButton.Importance = widget.DangerImportance
+
+	// Enter подтверждает удаление, Esc – отменяет
+	window.Canvas().SetOnTypedKey(func(event *fyne.KeyEvent) {
+		switch event.Name {
+		case fyne.KeyReturn, fyne.KeyEnter:
+			confirmButton.OnTapped()
+		case fyne.KeyEscape:
+			window.Close()
+		}
+	})
+
+	buttons := container.NewHBox(layout.NewSpacer(), confirmButton, closeButton)
+
+	window.SetContent(container.NewBorder(nil, buttons, nil, nil, content))
+
+	return window
+}
+
+// BuildMany – одно окно подтверждения для нескольких контактов со списком их имен.
+//
+// Контакты удаляются за одно сохранение. Если список не удалось прочитать,
+// ошибка показывается в главном окне и возвращается nil.
+func (b *Builder) BuildMany(contactUuids []string) fyne.Window {
+	contacts, err := b.fetchHandler.Fetch(context.Background())
+	if err != nil {
+		b.reporter.Error(nil, "contacts.load", err, func() {
+			if window := b.BuildMany(contactUuids); window != nil {
+				window.Show()
+			}
+		})
+		return nil
+	}
+
+	names := make([]string, 0, len(contactUuids))
+	for _, contact := range contacts {
+		if slices.Contains(contactUuids, contact.UUID) {
+			names = append(names, strings.TrimSpace(contact.Surname+" "+contact.Name))
+		}
+	}
+	slices.Sort(names)
+
+	window := b.app.NewWindow(b.localizer.T("contact.delete.many.title"))
+	window.Resize(manyWindowSize)
+	window.CenterOnScreen()
+
+	label := widget.NewLabel(b.localizer.Plural("contact.delete.many.confirm", len(contactUuids), nil))
+	label.Wrapping = fyne.TextWrapWord
+
+	namesLabel := widget.NewLabel(strings.Join(names, "\n"))
+	namesLabel.Wrapping = fyne.TextWrapWord
+
+	closeButton := widget.NewButton(b.localizer.T("button.cancel"), func() {
+		window.Close()
+	})
+
+	var confirmButton *widget.Button
+	confirmButton = widget.NewButton(b.localizer.T("button.ok"), func() {
+		err = b.deleteHandler.DeleteMany(context.Background(), contactUuids)
+		if err != nil {
+			b.reporter.Error(window, "contacts.delete", err, confirmButton.OnTapped, "count", len(contactUuids))
+			return
 		}
 
 		b.contactList.Refresh()
 
 		window.Close()
 	})
-	confirmButton.Resize(buttonSize)
-	confirmButton.Move(
-		fyne.NewPos(
-			windowSize.Width-buttonSize.Width*2-20*2,
-			windowSize.Height-buttonSize.Height-20,
-		),
-	)
+	confirmButton.Importance = widget.DangerImportance
+
+	// Enter подтверждает удаление, Esc – отменяет
+	window.Canvas().SetOnTypedKey(func(event *fyne.KeyEvent) {
+		switch event.Name {
+		case fyne.KeyReturn, fyne.KeyEnter:
+			confirmButton.OnTapped()
+		case fyne.KeyEscape:
+			window.Close()
+		}
+	})
+
+	buttons := container.NewHBox(layout.NewSpacer(), confirmButton, closeButton)
 
-	box := container.NewWithoutLayout()
-	box.Add(label)
-	box.Add(closeButton)
-	box.Add(confirmButton)
+	header := container.NewVBox(label)
+	if warning := b.relationsWarning(contacts, contactUuids); warning != nil {
+		header.Add(warning)
+	}
 
-	window.SetContent(box)
+	window.SetContent(container.NewBorder(header, buttons, nil, nil, container.NewVScroll(namesLabel)))
 
 	return window
 }
+
+// relationsWarning – предупреждение о связях с контактами, которые останутся, nil – таких связей нет.
+//
+// Хранилище удалит эти связи вместе с контактами.
+func (b *Builder) relationsWarning(contacts []model.Contact, deleted []string) fyne.CanvasObject {
+	count := 0
+	for _, contact := range contacts {
+		for _, relation := range contact.Relations {
+			// Связь между двумя удаляемыми контактами никого не затронет
+			if slices.Contains(deleted, contact.UUID) != slices.Contains(deleted, relation.UUID) {
+				count++
+			}
+		}
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	warning := widget.NewLabel(b.localizer.Plural("contact.delete.relations", count, nil))
+	warning.Wrapping = fyne.TextWrapWord
+	warning.Importance = widget.WarningImportance
+
+	return warning
+}
@@ -0,0 +1,15 @@
+package export_contacts
+
+import (
+	"context"
+
+	"fyne.io/fyne/v2"
+)
+
+type exportHandler interface {
+	Export(ctx context.Context, uuids []string) ([]byte, error)
+}
+
+type reporter interface {
+	Error(window fyne.Window, operation string, err error, retry func(), attrs ...any)
+}
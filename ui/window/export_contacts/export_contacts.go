@@ -0,0 +1,72 @@
+package export_contacts
+
+import (
+	"context"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+)
+
+const fileName = "contacts.vcf"
+
+type Builder struct {
+	window        fyne.Window
+	exportHandler exportHandler
+	reporter      reporter
+}
+
+// NewBuilder – экспорт выбранных контактов, диалог сохранения открывается поверх window
+func NewBuilder(
+	window fyne.Window,
+	exportHandler exportHandler,
+	reporter reporter,
+) *Builder {
+	return &Builder{
+		window:        window,
+		exportHandler: exportHandler,
+		reporter:      reporter,
+	}
+}
+
+// Show – спросить, куда сохранить файл, и записать в него контакты в формате vCard
+func (b *Builder) Show(contactUuids []string) {
+	if len(contactUuids) == 0 {
+		return
+	}
+
+	save := dialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+		if err != nil {
+			b.reporter.Error(b.window, "contacts.export", err, nil)
+			return
+		}
+
+		// Пользователь закрыл диалог
+		if writer == nil {
+			return
+		}
+		defer writer.Close()
+
+		err = b.export(writer, contactUuids)
+		if err != nil {
+			b.reporter.Error(b.window, "contacts.export", err, func() {
+				b.Show(contactUuids)
+			}, "count", len(contactUuids), "uri", writer.URI().String())
+		}
+	}, b.window)
+
+	save.SetFileName(fileName)
+	save.SetFilter(storage.NewExtensionFileFilter([]string{".vcf"}))
+	save.Show()
+}
+
+func (b *Builder) export(writer fyne.URIWriteCloser, contactUuids []string) error {
+	data, err := b.exportHandler.Export(context.Background(), contactUuids)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(data)
+
+	return err
+}
@@ -0,0 +1,39 @@
+package merge_contacts
+
+import (
+	"context"
+	"time"
+
+	"fyne.io/fyne/v2"
+
+	"contacts/internal/model"
+)
+
+type app interface {
+	NewWindow(title string) fyne.Window
+}
+
+type contactList interface {
+	Refresh()
+}
+
+type duplicatesHandler interface {
+	Find(ctx context.Context) ([]model.DuplicatePair, error)
+}
+
+type mergeHandler interface {
+	Merge(ctx context.Context, request model.MergeRequest) (model.Contact, error)
+}
+
+type organizationHandler interface {
+	List(ctx context.Context) ([]model.Organization, error)
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+}
+
+type dates interface {
+	Format(t time.Time) string
+}
@@ -0,0 +1,305 @@
+package merge_contacts
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+	"contacts/ui/presenter/phone"
+	"contacts/ui/shortcut"
+)
+
+const emptyValue = "—"
+
+// Сколько символов заметок показывать при выборе значения
+const notesPreviewLength = 40
+
+var windowSize = fyne.NewSize(600, 450)
+
+// fieldChoice – строка мастера: поле и значения в обоих контактах
+type fieldChoice struct {
+	field  model.Field
+	label  string
+	target string
+	source string
+}
+
+type Builder struct {
+	app                 app
+	contactList         contactList
+	duplicatesHandler   duplicatesHandler
+	mergeHandler        mergeHandler
+	organizationHandler organizationHandler
+	localizer           localizer
+	dates               dates
+}
+
+func NewBuilder(
+	app app,
+	contactList contactList,
+	duplicatesHandler duplicatesHandler,
+	mergeHandler mergeHandler,
+	organizationHandler organizationHandler,
+	localizer localizer,
+	dates dates,
+) *Builder {
+	return &Builder{
+		app:                 app,
+		contactList:         contactList,
+		duplicatesHandler:   duplicatesHandler,
+		mergeHandler:        mergeHandler,
+		organizationHandler: organizationHandler,
+		localizer:           localizer,
+		dates:               dates,
+	}
+}
+
+// Build – мастер, который по очереди показывает пары похожих контактов
+// и позволяет объединить их, выбрав значение каждого конфликтующего поля.
+func (b *Builder) Build() fyne.Window {
+	window := b.app.NewWindow(b.localizer.T("merge.title"))
+	window.Resize(windowSize)
+	window.CenterOnScreen()
+	shortcut.CloseOnEscape(window)
+
+	pairs, err := b.duplicatesHandler.Find(context.Background())
+	if err != nil {
+		window.SetContent(widget.NewLabel(b.localizer.Format("merge.error", map[string]any{
+			"Error": err.Error(),
+		})))
+		return window
+	}
+
+	b.showStep(window, pairs, 0)
+
+	return window
+}
+
+func (b *Builder) showStep(window fyne.Window, pairs []model.DuplicatePair, index int) {
+	closeButton := widget.NewButton(b.localizer.T("button.close"), func() {
+		window.Close()
+	})
+
+	if index >= len(pairs) {
+		window.SetContent(container.NewBorder(nil, container.NewHBox(closeButton), nil, nil,
+			widget.NewLabel(b.localizer.T("merge.none")),
+		))
+		return
+	}
+
+	pair := pairs[index]
+
+	header := widget.NewLabel(b.localizer.Format("merge.header", map[string]any{
+		"Index": index + 1,
+		"Total": len(pairs),
+		"Score": int(math.Round(pair.Score * 100)),
+	}))
+	header.TextStyle = fyne.TextStyle{Bold: true}
+
+	form := container.NewVBox()
+
+	// Выбранное значение каждой строки, по умолчанию – непустое из первого контакта
+	radios := make(map[model.Field]*widget.RadioGroup)
+
+	for _, choice := range b.fieldChoices(pair) {
+		if choice.target == choice.source {
+			form.Add(container.NewHBox(widget.NewLabel(choice.label+":"), widget.NewLabel(choice.target)))
+			continue
+		}
+
+		radio := widget.NewRadioGroup([]string{choice.target, choice.source}, nil)
+		radio.Horizontal = true
+		radio.Required = true
+		radio.SetSelected(choice.target)
+		if choice.target == emptyValue {
+			radio.SetSelected(choice.source)
+		}
+		radios[choice.field] = radio
+
+		form.Add(container.NewHBox(widget.NewLabel(choice.label+":"), radio))
+	}
+
+	skipButton := widget.NewButton(b.localizer.T("merge.skip"), func() {
+		b.showStep(window, pairs, index+1)
+	})
+
+	mergeButton := widget.NewButton(b.localizer.T("merge.merge"), func() {
+		choices := make(map[model.Field]model.MergeSide, len(radios))
+		for field, radio := range radios {
+			choices[field] = model.MergeSideTarget
+			if radio.Selected == radio.Options[1] {
+				choices[field] = model.MergeSideSource
+			}
+		}
+
+		_, err := b.mergeHandler.Merge(context.Background(), model.MergeRequest{
+			TargetUUID: pair.First.UUID,
+			SourceUUID: pair.Second.UUID,
+			Choices:    choices,
+		})
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+
+		b.contactList.Refresh()
+
+		// После объединения пары могли измениться, поэтому ищем их заново
+		pairs, err = b.duplicatesHandler.Find(context.Background())
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+
+		b.showStep(window, pairs, index)
+	})
+	mergeButton.Importance = widget.HighImportance
+
+	buttons := container.NewHBox(skipButton, mergeButton, closeButton)
+
+	window.SetContent(container.NewBorder(header, buttons, nil, nil, container.NewVScroll(form)))
+}
+
+// fieldChoices – строки мастера для всех полей пары контактов
+func (b *Builder) fieldChoices(pair model.DuplicatePair) []fieldChoice {
+	target, source := pair.First, pair.Second
+
+	choices := []fieldChoice{
+		{
+			field:  model.FieldSurname,
+			label:  b.localizer.T("field.surname"),
+			target: present(target.Surname),
+			source: present(source.Surname),
+		},
+		{
+			field:  model.FieldName,
+			label:  b.localizer.T("field.name"),
+			target: present(target.Name),
+			source: present(source.Name),
+		},
+		{
+			field:  model.FieldBirthday,
+			label:  b.localizer.T("field.birthday"),
+			target: b.dates.Format(target.Birthday),
+			source: b.dates.Format(source.Birthday),
+		},
+		{
+			field:  model.FieldPhone,
+			label:  b.localizer.T("field.phone"),
+			target: phone.Present(target.Phone.Number()),
+			source: phone.Present(source.Phone.Number()),
+		},
+		{
+			field:  model.FieldEmail,
+			label:  b.localizer.T("field.email"),
+			target: present(target.Email),
+			source: present(source.Email),
+		},
+		{
+			field:  model.FieldAvatar,
+			label:  b.localizer.T("field.photo"),
+			target: presentAvatar(target.Avatar, b.localizer.T("merge.photo.first")),
+			source: presentAvatar(source.Avatar, b.localizer.T("merge.photo.second")),
+		},
+	}
+
+	// Одинаковое фото в обоих контактах выбирать не нужно
+	if target.Avatar == source.Avatar {
+		choices[len(choices)-1].source = choices[len(choices)-1].target
+	}
+
+	// Организация, отдел и должность выбираются одной строкой, потому что объединяются вместе.
+	// Организацию показываем по названию, без списка организаций выбор не предлагаем,
+	// тогда место работы выбирается автоматически
+	organizations, err := b.organizationHandler.List(context.Background())
+	if err == nil {
+		names := make(map[string]string, len(organizations))
+		for _, organization := range organizations {
+			names[organization.UUID] = organization.Name
+		}
+
+		choices = append(choices, fieldChoice{
+			field:  model.FieldOrganization,
+			label:  b.localizer.T("field.organization"),
+			target: presentWork(names[target.OrganizationUUID], target.Department, target.Title),
+			source: presentWork(names[source.OrganizationUUID], source.Department, source.Title),
+		})
+	}
+
+	choices = append(choices, fieldChoice{
+		field:  model.FieldNotes,
+		label:  b.localizer.T("field.notes"),
+		target: presentNotes(target.Notes),
+		source: presentNotes(source.Notes),
+	})
+
+	// Ссылки, которые есть хотя бы в одном из контактов
+	links := make([]string, 0)
+	for link := range target.Links {
+		links = append(links, string(link))
+	}
+	for link := range source.Links {
+		if _, ok := target.Links[link]; !ok {
+			links = append(links, string(link))
+		}
+	}
+	sort.Strings(links)
+
+	for _, link := range links {
+		choices = append(choices, fieldChoice{
+			field:  model.Field(link),
+			label:  link,
+			target: present(target.Links[model.ContactLink(link)]),
+			source: present(source.Links[model.ContactLink(link)]),
+		})
+	}
+
+	return choices
+}
+
+func present(value string) string {
+	if value == "" {
+		return emptyValue
+	}
+
+	return value
+}
+
+// presentWork – место работы одной строкой, как в списке сотрудников организации
+func presentWork(organization, department, title string) string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{organization, department, title} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return present(strings.Join(parts, " · "))
+}
+
+// presentNotes – первая строка заметок, длинная строка обрезается
+func presentNotes(notes string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(notes), "\n")
+
+	runes := []rune(strings.TrimSpace(line))
+	if len(runes) > notesPreviewLength {
+		return string(runes[:notesPreviewLength]) + "…"
+	}
+
+	return present(string(runes))
+}
+
+func presentAvatar(hash string, label string) string {
+	if hash == "" {
+		return emptyValue
+	}
+
+	return label
+}
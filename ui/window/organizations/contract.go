@@ -0,0 +1,35 @@
+package organizations
+
+import (
+	"context"
+
+	"fyne.io/fyne/v2"
+
+	"contacts/internal/model"
+)
+
+type app interface {
+	NewWindow(title string) fyne.Window
+}
+
+type contactList interface {
+	Refresh()
+}
+
+type organizationHandler interface {
+	List(ctx context.Context) ([]model.Organization, error)
+	Create(ctx context.Context, name string) (model.Organization, error)
+	Rename(ctx context.Context, uuid, name string) error
+	Merge(ctx context.Context, targetUUID, sourceUUID string) error
+	Members(ctx context.Context, uuid string) ([]model.Contact, error)
+}
+
+type reporter interface {
+	Error(window fyne.Window, operation string, err error, retry func(), attrs ...any)
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+	Plural(id string, count int, params map[string]any) string
+}
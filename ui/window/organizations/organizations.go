@@ -0,0 +1,262 @@
+package organizations
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/internal/model"
+	"contacts/ui/shortcut"
+)
+
+var windowSize = fyne.NewSize(640, 420)
+
+// Доля ширины окна под список организаций
+const listSplitOffset = 0.35
+
+type Builder struct {
+	app                 app
+	contactList         contactList
+	organizationHandler organizationHandler
+	reporter            reporter
+	localizer           localizer
+}
+
+func NewBuilder(
+	app app,
+	contactList contactList,
+	organizationHandler organizationHandler,
+	reporter reporter,
+	localizer localizer,
+) *Builder {
+	return &Builder{
+		app:                 app,
+		contactList:         contactList,
+		organizationHandler: organizationHandler,
+		reporter:            reporter,
+		localizer:           localizer,
+	}
+}
+
+// Build – окно со списком организаций.
+//
+// Выбранную организацию можно переименовать, объединить с другой и посмотреть ее сотрудников.
+func (b *Builder) Build() fyne.Window {
+	window := b.app.NewWindow(b.localizer.T("organizations.title"))
+	window.Resize(windowSize)
+	window.CenterOnScreen()
+	shortcut.CloseOnEscape(window)
+
+	var (
+		organizations []model.Organization
+		list          *widget.List
+		reload        func(selectUUID string)
+	)
+
+	details := container.NewStack()
+
+	list = widget.NewList(
+		func() int {
+			return len(organizations)
+		},
+		func() fyne.CanvasObject {
+			label := widget.NewLabel("")
+			label.Truncation = fyne.TextTruncateEllipsis
+
+			return label
+		},
+		func(id widget.ListItemID, object fyne.CanvasObject) {
+			object.(*widget.Label).SetText(organizations[id].Name)
+		},
+	)
+	list.OnSelected = func(id widget.ListItemID) {
+		details.Objects = []fyne.CanvasObject{b.buildDetails(window, organizations, organizations[id], reload)}
+		details.Refresh()
+	}
+
+	// reload – перечитывает организации и снова выбирает selectUUID, если она осталась
+	reload = func(selectUUID string) {
+		var err error
+
+		organizations, err = b.organizationHandler.List(context.Background())
+		if err != nil {
+			b.reporter.Error(window, "organizations.load", err, func() {
+				reload(selectUUID)
+			})
+			organizations = nil
+		}
+
+		list.UnselectAll()
+		details.Objects = nil
+		details.Refresh()
+		list.Refresh()
+
+		index := slices.IndexFunc(organizations, func(organization model.Organization) bool {
+			return organization.UUID == selectUUID
+		})
+		if index >= 0 {
+			list.Select(index)
+		}
+	}
+
+	addButton := widget.NewButtonWithIcon(b.localizer.T("organizations.add"), theme.ContentAddIcon(), func() {
+		b.showCreateDialog(window, reload)
+	})
+
+	reload("")
+
+	split := container.NewHSplit(
+		container.NewBorder(nil, addButton, nil, nil, list),
+		container.NewVScroll(details),
+	)
+	split.Offset = listSplitOffset
+
+	window.SetContent(split)
+
+	return window
+}
+
+// showCreateDialog – название новой организации
+func (b *Builder) showCreateDialog(window fyne.Window, reload func(selectUUID string)) {
+	entry := widget.NewEntry()
+
+	var create func()
+	create = func() {
+		organization, err := b.organizationHandler.Create(context.Background(), entry.Text)
+		if err != nil {
+			b.reporter.Error(window, "organization.create", err, create, "name", entry.Text)
+			return
+		}
+
+		reload(organization.UUID)
+	}
+
+	dialog.ShowForm(
+		b.localizer.T("organizations.add"),
+		b.localizer.T("button.ok"),
+		b.localizer.T("button.cancel"),
+		[]*widget.FormItem{
+			widget.NewFormItem(b.localizer.T("organizations.name"), entry),
+		},
+		func(confirmed bool) {
+			if confirmed && strings.TrimSpace(entry.Text) != "" {
+				create()
+			}
+		},
+		window,
+	)
+}
+
+// buildDetails – переименование, объединение и сотрудники организации
+func (b *Builder) buildDetails(
+	window fyne.Window,
+	organizations []model.Organization,
+	organization model.Organization,
+	reload func(selectUUID string),
+) fyne.CanvasObject {
+	// Переименование
+	nameEntry := widget.NewEntry()
+	nameEntry.SetText(organization.Name)
+
+	var renameButton *widget.Button
+	renameButton = widget.NewButton(b.localizer.T("organizations.rename"), func() {
+		err := b.organizationHandler.Rename(context.Background(), organization.UUID, nameEntry.Text)
+		if err != nil {
+			b.reporter.Error(window, "organization.rename", err, renameButton.OnTapped, "uuid", organization.UUID)
+			return
+		}
+
+		b.contactList.Refresh()
+		reload(organization.UUID)
+	})
+
+	// Объединение: выбранная организация переходит в другую и пропадает
+	others := slices.DeleteFunc(slices.Clone(organizations), func(other model.Organization) bool {
+		return other.UUID == organization.UUID
+	})
+	titles := make([]string, 0, len(others))
+	for _, other := range others {
+		titles = append(titles, other.Name)
+	}
+
+	targetSelect := widget.NewSelect(titles, nil)
+	targetSelect.PlaceHolder = b.localizer.T("organizations.merge.pick")
+
+	var mergeButton *widget.Button
+	mergeButton = widget.NewButton(b.localizer.T("organizations.merge"), func() {
+		index := targetSelect.SelectedIndex()
+		if index < 0 {
+			return
+		}
+		target := others[index]
+
+		message := b.localizer.Format("organizations.merge.confirm", map[string]any{
+			"Source": organization.Name,
+			"Target": target.Name,
+		})
+
+		dialog.ShowConfirm(b.localizer.T("organizations.merge"), message, func(confirmed bool) {
+			if !confirmed {
+				return
+			}
+
+			err := b.organizationHandler.Merge(context.Background(), target.UUID, organization.UUID)
+			if err != nil {
+				b.reporter.Error(window, "organization.merge", err, mergeButton.OnTapped, "source", organization.UUID, "target", target.UUID)
+				return
+			}
+
+			b.contactList.Refresh()
+			reload(target.UUID)
+		}, window)
+	})
+	if len(others) == 0 {
+		targetSelect.Disable()
+		mergeButton.Disable()
+	}
+
+	form := container.New(
+		layout.NewFormLayout(),
+		widget.NewLabel(b.localizer.T("organizations.name")+":"),
+		container.NewBorder(nil, nil, nil, renameButton, nameEntry),
+		widget.NewLabel(b.localizer.T("organizations.merge.into")+":"),
+		container.NewBorder(nil, nil, nil, mergeButton, targetSelect),
+	)
+
+	return container.NewVBox(form, widget.NewSeparator(), b.buildMembers(window, organization))
+}
+
+// buildMembers – сотрудники организации с отделом и должностью
+func (b *Builder) buildMembers(window fyne.Window, organization model.Organization) fyne.CanvasObject {
+	members, err := b.organizationHandler.Members(context.Background(), organization.UUID)
+	if err != nil {
+		b.reporter.Error(window, "organization.members", err, nil, "uuid", organization.UUID)
+		return container.NewVBox()
+	}
+
+	title := widget.NewLabel(b.localizer.Plural("organizations.members", len(members), nil))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	box := container.NewVBox(title)
+	for _, member := range members {
+		parts := []string{strings.TrimSpace(member.Surname + " " + member.Name)}
+		for _, part := range []string{member.Department, member.Title} {
+			if strings.TrimSpace(part) != "" {
+				parts = append(parts, part)
+			}
+		}
+
+		label := widget.NewLabel(strings.Join(parts, " · "))
+		label.Truncation = fyne.TextTruncateEllipsis
+		box.Add(label)
+	}
+
+	return box
+}
@@ -0,0 +1,23 @@
+package settings
+
+import (
+	"fyne.io/fyne/v2"
+
+	"contacts/ui/appearance"
+)
+
+type app interface {
+	NewWindow(title string) fyne.Window
+	Settings() fyne.Settings
+	Preferences() fyne.Preferences
+}
+
+type store interface {
+	Load() appearance.Settings
+	Save(settings appearance.Settings)
+}
+
+type localizer interface {
+	T(id string) string
+	Format(id string, params map[string]any) string
+}
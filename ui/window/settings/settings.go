@@ -0,0 +1,204 @@
+package settings
+
+import (
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/ui/appearance"
+	"contacts/ui/shortcut"
+)
+
+var (
+	windowSize = fyne.NewSize(420, 300)
+)
+
+// Шаг ползунка масштаба шрифта
+const fontScaleStep = 0.1
+
+// LocalePreferenceKey – язык интерфейса, выбранный в настройках, пустой – язык системы
+const LocalePreferenceKey = "locale"
+
+// Overrides – значения из конфигурации запуска, они перекрывают настройки и не сохраняются
+type Overrides struct {
+	Variant appearance.Variant // Тема, пустая – не задана
+	Locale  string             // Язык интерфейса, пустой – не задан
+}
+
+type Builder struct {
+	app       app
+	store     store
+	localizer localizer
+	locales   []string
+	overrides Overrides
+}
+
+// NewBuilder – locales – языки, на которые переведен интерфейс
+func NewBuilder(app app, store store, localizer localizer, locales []string, overrides Overrides) *Builder {
+	return &Builder{
+		app:       app,
+		store:     store,
+		localizer: localizer,
+		locales:   locales,
+		overrides: overrides,
+	}
+}
+
+// Build – окно настроек внешнего вида и языка.
+//
+// Изменения внешнего вида применяются сразу, язык – после перезапуска. Все сохраняется в настройках приложения.
+func (b *Builder) Build() fyne.Window {
+	window := b.app.NewWindow(b.localizer.T("settings.title"))
+	window.Resize(windowSize)
+	window.CenterOnScreen()
+	shortcut.CloseOnEscape(window)
+
+	// На экране тема из конфигурации, а сохраняется выбранная пользователем
+	stored := b.store.Load()
+	current := stored
+	if b.overrides.Variant != "" {
+		current.Variant = b.overrides.Variant
+	}
+
+	apply := func() {
+		saved := current
+		saved.Variant = stored.Variant
+		b.store.Save(saved)
+		appearance.Apply(b.app.Settings(), current)
+	}
+
+	// Тема: системная, светлая или темная
+	variantLabels := make([]string, 0, len(appearance.Variants()))
+	variantByLabel := make(map[string]appearance.Variant, len(appearance.Variants()))
+	for _, variant := range appearance.Variants() {
+		label := b.localizer.T("settings.theme." + string(variant))
+		variantLabels = append(variantLabels, label)
+		variantByLabel[label] = variant
+	}
+
+	variantRadio := widget.NewRadioGroup(variantLabels, nil)
+	variantRadio.Horizontal = true
+	variantRadio.Required = true
+	variantRadio.SetSelected(b.localizer.T("settings.theme." + string(current.Variant)))
+	variantRadio.OnChanged = func(selected string) {
+		current.Variant = variantByLabel[selected]
+		stored.Variant = current.Variant
+		apply()
+	}
+
+	variantBox := container.NewVBox(variantRadio)
+	if b.overrides.Variant != "" {
+		variantRadio.Disable()
+
+		locked := widget.NewLabel(b.localizer.T("settings.locked"))
+		locked.Importance = widget.LowImportance
+		variantBox.Add(locked)
+	}
+
+	// Акцентный цвет
+	accentLabels := make([]string, 0, len(appearance.Accents()))
+	accentByLabel := make(map[string]string, len(appearance.Accents()))
+	for _, accent := range appearance.Accents() {
+		label := b.localizer.T("settings.accent." + accent)
+		accentLabels = append(accentLabels, label)
+		accentByLabel[label] = accent
+	}
+
+	accentSelect := widget.NewSelect(accentLabels, nil)
+	accentSelect.SetSelected(b.localizer.T("settings.accent." + current.Accent))
+	accentSelect.OnChanged = func(selected string) {
+		current.Accent = accentByLabel[selected]
+		apply()
+	}
+
+	// Масштаб шрифта, тема применяется после отпускания ползунка
+	fontScaleLabel := widget.NewLabel(b.presentFontScale(current.FontScale))
+
+	fontScaleSlider := widget.NewSlider(appearance.MinFontScale, appearance.MaxFontScale)
+	fontScaleSlider.Step = fontScaleStep
+	fontScaleSlider.SetValue(current.FontScale)
+	fontScaleSlider.OnChanged = func(value float64) {
+		fontScaleLabel.SetText(b.presentFontScale(value))
+	}
+	fontScaleSlider.OnChangeEnded = func(value float64) {
+		current.FontScale = value
+		apply()
+	}
+
+	form := container.New(
+		layout.NewFormLayout(),
+		widget.NewLabel(b.localizer.T("settings.theme")), variantBox,
+		widget.NewLabel(b.localizer.T("settings.accent")), accentSelect,
+		widget.NewLabel(b.localizer.T("settings.font_scale")), container.NewBorder(nil, nil, nil, fontScaleLabel, fontScaleSlider),
+		widget.NewLabel(b.localizer.T("settings.language")), b.buildLanguageSelect(),
+	)
+
+	closeButton := widget.NewButton(b.localizer.T("button.close"), window.Close)
+
+	window.SetContent(container.NewBorder(
+		nil,
+		container.NewHBox(layout.NewSpacer(), closeButton),
+		nil,
+		nil,
+		form,
+	))
+
+	return window
+}
+
+// buildLanguageSelect – язык интерфейса, первый вариант – язык системы.
+//
+// Язык из конфигурации важнее настройки, тогда выбор недоступен, как и выбор темы.
+func (b *Builder) buildLanguageSelect() fyne.CanvasObject {
+	locales := append([]string{""}, b.locales...)
+
+	titles := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		titles = append(titles, b.languageTitle(locale))
+	}
+
+	hint := widget.NewLabel(b.localizer.T("settings.language.restart"))
+	hint.Importance = widget.LowImportance
+	hint.Hide()
+
+	languageSelect := widget.NewSelect(titles, nil)
+
+	if b.overrides.Locale != "" {
+		languageSelect.SetSelected(b.languageTitle(b.overrides.Locale))
+		languageSelect.Disable()
+		hint.SetText(b.localizer.T("settings.locked"))
+		hint.Show()
+
+		return container.NewVBox(languageSelect, hint)
+	}
+
+	preferences := b.app.Preferences()
+	languageSelect.SetSelectedIndex(max(slices.Index(locales, preferences.String(LocalePreferenceKey)), 0))
+
+	// Обработчик назначается после начального выбора, SetSelectedIndex вызывает OnChanged
+	languageSelect.OnChanged = func(string) {
+		preferences.SetString(LocalePreferenceKey, locales[languageSelect.SelectedIndex()])
+		hint.Show()
+	}
+
+	return container.NewVBox(languageSelect, hint)
+}
+
+// languageTitle – название языка на нем самом, пустой – язык системы
+func (b *Builder) languageTitle(locale string) string {
+	if locale == "" {
+		return b.localizer.T("settings.language.system")
+	}
+
+	return b.localizer.T("settings.language." + locale)
+}
+
+// presentFontScale – масштаб в процентах, например "120%"
+func (b *Builder) presentFontScale(scale float64) string {
+	return b.localizer.Format("settings.font_scale.value", map[string]any{
+		"Percent": int(scale*100 + 0.5),
+	})
+}
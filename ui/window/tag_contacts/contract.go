@@ -0,0 +1,28 @@
+package tag_contacts
+
+import (
+	"context"
+
+	"fyne.io/fyne/v2"
+)
+
+type app interface {
+	NewWindow(title string) fyne.Window
+}
+
+type contactList interface {
+	Refresh()
+}
+
+type tagHandler interface {
+	Assign(ctx context.Context, uuids []string, tag string) error
+}
+
+type reporter interface {
+	Error(window fyne.Window, operation string, err error, retry func(), attrs ...any)
+}
+
+type localizer interface {
+	T(id string) string
+	Plural(id string, count int, params map[string]any) string
+}
@@ -0,0 +1,94 @@
+package tag_contacts
+
+import (
+	"context"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+
+	"contacts/ui/shortcut"
+)
+
+var (
+	windowSize = fyne.NewSize(360, 140)
+)
+
+type Builder struct {
+	app         app
+	contactList contactList
+	tagHandler  tagHandler
+	reporter    reporter
+	localizer   localizer
+}
+
+func NewBuilder(
+	app app,
+	contactList contactList,
+	tagHandler tagHandler,
+	reporter reporter,
+	localizer localizer,
+) *Builder {
+	return &Builder{
+		app:         app,
+		contactList: contactList,
+		tagHandler:  tagHandler,
+		reporter:    reporter,
+		localizer:   localizer,
+	}
+}
+
+// Build – окно с меткой, которая добавляется всем выбранным контактам
+func (b *Builder) Build(contactUuids []string) fyne.Window {
+	window := b.app.NewWindow(b.localizer.T("contact.tag.title"))
+	window.Resize(windowSize)
+	window.CenterOnScreen()
+	shortcut.CloseOnEscape(window)
+
+	label := widget.NewLabel(b.localizer.Plural("contact.tag.label", len(contactUuids), nil))
+
+	entry := widget.NewEntry()
+	entry.SetPlaceHolder(b.localizer.T("contact.tag.placeholder"))
+
+	closeButton := widget.NewButton(b.localizer.T("button.cancel"), func() {
+		window.Close()
+	})
+
+	var confirmButton *widget.Button
+	confirmButton = widget.NewButton(b.localizer.T("button.ok"), func() {
+		err := b.tagHandler.Assign(context.Background(), contactUuids, entry.Text)
+		if err != nil {
+			b.reporter.Error(window, "contacts.tag", err, confirmButton.OnTapped, "count", len(contactUuids))
+			return
+		}
+
+		b.contactList.Refresh()
+
+		window.Close()
+	})
+	confirmButton.Importance = widget.HighImportance
+	confirmButton.Disable()
+
+	// Пустую метку добавить нельзя
+	entry.OnChanged = func(text string) {
+		if strings.TrimSpace(text) == "" {
+			confirmButton.Disable()
+		} else {
+			confirmButton.Enable()
+		}
+	}
+	entry.OnSubmitted = func(string) {
+		if !confirmButton.Disabled() {
+			confirmButton.OnTapped()
+		}
+	}
+
+	buttons := container.NewHBox(layout.NewSpacer(), confirmButton, closeButton)
+
+	window.SetContent(container.NewBorder(nil, buttons, nil, nil, container.NewVBox(label, entry)))
+	window.Canvas().Focus(entry)
+
+	return window
+}
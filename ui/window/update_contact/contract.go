@@ -2,6 +2,7 @@ package update_contact
 
 import (
 	"context"
+	"time"
 
 	"fyne.io/fyne/v2"
 
@@ -16,11 +17,41 @@ type contactList interface {
 	Refresh()
 }
 
+type avatarBuilder interface {
+	Resource(hash string) fyne.Resource
+	Pick(window fyne.Window, onPicked func(hash string))
+}
+
 type updateHandler interface {
-	Update(ctx context.Context, contactForCreate model.ContactForCreate) (map[model.Field]string, error)
+	Update(ctx context.Context, contactForCreate model.ContactForCreate) (map[model.Field]model.Message, error)
 }
 
 type fetchHandler interface {
 	Fetch(ctx context.Context) ([]model.Contact, error)
 	FetchByUuid(ctx context.Context, uuid string) (model.Contact, error)
 }
+
+type organizationHandler interface {
+	List(ctx context.Context) ([]model.Organization, error)
+}
+
+type validator interface {
+	ValidateField(field model.Field, value string) (model.Message, bool)
+}
+
+type reporter interface {
+	Log(operation string, err error, attrs ...any)
+	Error(window fyne.Window, operation string, err error, retry func(), attrs ...any)
+}
+
+type localizer interface {
+	T(id string) string
+	Message(message model.Message) string
+}
+
+type dates interface {
+	Parse(value string) (time.Time, error)
+	Format(t time.Time) string
+	FormatDateTime(t time.Time) string
+	Example() string
+}
@@ -0,0 +1,13 @@
+package clock
+
+import "time"
+
+type Clock struct{}
+
+func New() *Clock {
+	return &Clock{}
+}
+
+func (c *Clock) Now() time.Time {
+	return time.Now()
+}
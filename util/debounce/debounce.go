@@ -0,0 +1,45 @@
+package debounce
+
+import (
+	"sync"
+	"time"
+)
+
+// Debouncer – откладывает вызов, пока вызовы идут чаще delay.
+//
+// Выполняется только последний вызов, например проверка поля после паузы в наборе.
+type Debouncer struct {
+	delay time.Duration
+
+	mu    sync.Mutex
+	timer *time.Timer
+}
+
+func New(delay time.Duration) *Debouncer {
+	return &Debouncer{
+		delay: delay,
+	}
+}
+
+// Call – выполнить f через delay, если за это время не будет нового вызова
+func (d *Debouncer) Call(f func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+
+	d.timer = time.AfterFunc(d.delay, f)
+}
+
+// Stop – отменить отложенный вызов
+func (d *Debouncer) Stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+}